@@ -19,13 +19,14 @@ func (a Amount) IsNegative() bool { return a < 0 }
 
 // StringFixed2 formats as "12.34" (always 2 decimals).
 func (a Amount) StringFixed2() string {
-	min := int64(a)
+	// uint64 magnitude so that math.MinInt64 formats correctly
+	mag := uint64(a)
 	sign := ""
-	if min < 0 {
+	if a < 0 {
 		sign = "-"
-		min = -min
+		mag = -mag
 	}
-	whole := min / 100
-	frac := min % 100
+	whole := mag / 100
+	frac := mag % 100
 	return fmt.Sprintf("%s%d.%02d", sign, whole, frac)
 }
@@ -0,0 +1,51 @@
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Binary format (stable across releases):
+//
+//	byte 0:  format version, currently 0x01
+//	byte 1+: minor units as a zigzag-encoded unsigned varint (encoding/binary.AppendVarint)
+//
+// 12.34 => 0x01 0xa4 0x13. Decoding rejects unknown versions, truncated or
+// non-minimal varints and trailing bytes. gob uses this format via BinaryMarshaler,
+// so gob streams written before it (Amount as a plain int) no longer decode.
+
+const binaryVersion = 0x01
+
+// AppendBinary implements encoding.BinaryAppender.
+func (a Amount) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, binaryVersion)
+	return binary.AppendVarint(b, int64(a)), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a Amount) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 1+binary.MaxVarintLen64))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (a *Amount) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		return fmt.Errorf("money: empty binary amount")
+	}
+	if b[0] != binaryVersion {
+		return fmt.Errorf("money: unsupported binary version %d", b[0])
+	}
+	v, n := binary.Varint(b[1:])
+	if n <= 0 {
+		return fmt.Errorf("money: invalid binary varint")
+	}
+	if n != len(b)-1 {
+		return fmt.Errorf("money: %d trailing bytes after binary amount", len(b)-1-n)
+	}
+	if b[n] == 0x00 && n > 1 {
+		// last byte 0x00 after a continuation byte: non-minimal encoding
+		return fmt.Errorf("money: non-minimal binary varint")
+	}
+	*a = Amount(v)
+	return nil
+}
@@ -0,0 +1,56 @@
+package money_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+// FuzzAmountBinary checks that decoding never panics and that every accepted
+// input is canonical (re-encodes to the same bytes).
+func FuzzAmountBinary(f *testing.F) {
+	for _, minor := range []int64{0, 1, -1, 1234, -1234, math.MaxInt64, math.MinInt64} {
+		b, _ := money.NewMinor(minor).MarshalBinary()
+		f.Add(b)
+	}
+	f.Add([]byte{})
+	f.Add([]byte{0x01, 0x80, 0x00})
+	f.Add([]byte{0x02, 0x00})
+
+	f.Fuzz(func(t *testing.T, in []byte) {
+		var a money.Amount
+		if err := a.UnmarshalBinary(in); err != nil {
+			return
+		}
+		out, err := a.MarshalBinary()
+		if err != nil {
+			t.Fatalf("re-marshal failed: in=%x err=%v", in, err)
+		}
+		if !bytes.Equal(in, out) {
+			t.Fatalf("non-canonical input accepted: in=%x out=%x", in, out)
+		}
+	})
+}
+
+// FuzzAmountBinaryRoundTrip checks MarshalBinary/UnmarshalBinary round-trips every int64.
+func FuzzAmountBinaryRoundTrip(f *testing.F) {
+	for _, minor := range []int64{0, 1, -1, 41929, math.MaxInt64, math.MinInt64} {
+		f.Add(minor)
+	}
+
+	f.Fuzz(func(t *testing.T, minor int64) {
+		b, err := money.NewMinor(minor).MarshalBinary()
+		if err != nil {
+			t.Fatalf("marshal err: %v", err)
+		}
+		var a money.Amount
+		if err := a.UnmarshalBinary(b); err != nil {
+			t.Fatalf("unmarshal err: minor=%d b=%x err=%v", minor, b, err)
+		}
+		if a.Minor() != minor {
+			t.Fatalf("round-trip mismatch: minor=%d got=%d", minor, a.Minor())
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package money_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = money.Amount(0)
+	_ encoding.BinaryAppender    = money.Amount(0)
+	_ encoding.BinaryUnmarshaler = (*money.Amount)(nil)
+)
+
+func TestAmount_MarshalBinary_Format(t *testing.T) {
+	// Golden values: changing these breaks stored data.
+	cases := []struct {
+		minor int64
+		want  []byte
+	}{
+		{0, []byte{0x01, 0x00}},
+		{-1, []byte{0x01, 0x01}},
+		{1, []byte{0x01, 0x02}},
+		{1234, []byte{0x01, 0xa4, 0x13}},
+		{-1234, []byte{0x01, 0xa3, 0x13}},
+		{math.MaxInt64, []byte{0x01, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{math.MinInt64, []byte{0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, tc := range cases {
+		got, err := money.NewMinor(tc.minor).MarshalBinary()
+		if err != nil {
+			t.Fatalf("minor=%d err: %v", tc.minor, err)
+		}
+		if !bytes.Equal(got, tc.want) {
+			t.Fatalf("minor=%d got=%x want=%x", tc.minor, got, tc.want)
+		}
+		var back money.Amount
+		if err := back.UnmarshalBinary(got); err != nil || back.Minor() != tc.minor {
+			t.Fatalf("minor=%d round-trip got=%d err=%v", tc.minor, back.Minor(), err)
+		}
+	}
+}
+
+func TestAmount_AppendBinary(t *testing.T) {
+	b, err := money.NewMinor(1234).AppendBinary([]byte("key:"))
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !bytes.Equal(b, []byte{'k', 'e', 'y', ':', 0x01, 0xa4, 0x13}) {
+		t.Fatalf("got=%x", b)
+	}
+}
+
+func TestAmount_UnmarshalBinary_Errors(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":       nil,
+		"no varint":   {0x01},
+		"version":     {0x02, 0x00},
+		"truncated":   {0x01, 0xa4},
+		"trailing":    {0x01, 0x00, 0x00},
+		"non-minimal": {0x01, 0x80, 0x00},
+		"overflow":    {0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02},
+	}
+	for name, in := range cases {
+		var a money.Amount
+		if err := a.UnmarshalBinary(in); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestAmount_Gob(t *testing.T) {
+	type cached struct {
+		Price  money.Amount
+		Prices []money.Amount
+	}
+	in := cached{Price: money.NewMinor(-1234), Prices: []money.Amount{1, 2, 3}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("encode err: %v", err)
+	}
+	var out cached
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("decode err: %v", err)
+	}
+	if out.Price != in.Price || len(out.Prices) != 3 || out.Prices[2] != 3 {
+		t.Fatalf("got=%+v want=%+v", out, in)
+	}
+}
+
+func TestAmount_Gob_LegacyStream(t *testing.T) {
+	// Streams written before BinaryMarshaler carried Amount as a plain gob int.
+	type legacy struct{ Price int64 }
+	type cached struct{ Price money.Amount }
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(legacy{Price: 1234}); err != nil {
+		t.Fatalf("encode err: %v", err)
+	}
+	old := buf.Bytes()
+
+	var out cached
+	if err := gob.NewDecoder(bytes.NewReader(old)).Decode(&out); err == nil {
+		t.Fatalf("expected legacy stream to be rejected")
+	}
+
+	// Migration: decode into the legacy shape and convert.
+	var l legacy
+	if err := gob.NewDecoder(bytes.NewReader(old)).Decode(&l); err != nil {
+		t.Fatalf("legacy decode err: %v", err)
+	}
+	if money.NewMinor(l.Price) != 1234 {
+		t.Fatalf("got=%d want=1234", l.Price)
+	}
+}
@@ -0,0 +1,237 @@
+package money
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// CSVOptions configures ReadCSV and WriteCSV.
+type CSVOptions struct {
+	Comma  rune                         // field delimiter; default ',' (Turkish Excel exports use ';')
+	Parse  func(string) (Amount, error) // default ParseString; e.g. LocaleParser(ParseTR)
+	Format func(Amount) string          // default StringFixed2; e.g. func(a Amount) string { return a.FormatLocale(opts) }
+}
+
+// LocaleParser returns a CSVOptions.Parse function using ParseLocale.
+func LocaleParser(opts ParseOptions) func(string) (Amount, error) {
+	return func(s string) (Amount, error) { return ParseLocale(s, opts) }
+}
+
+// CSVFieldError is a single bad cell, or a bad row when Column is empty
+// (a wrong number of fields wrapping csv.ErrFieldCount, or a quoting error such as csv.ErrBareQuote).
+type CSVFieldError struct {
+	Line   int    // 1-based line in the file (the header is line 1)
+	Column string // header name; empty for row errors
+	Value  string
+	Err    error
+}
+
+func (e *CSVFieldError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %q, value %q: %v", e.Line, e.Column, e.Value, e.Err)
+}
+
+func (e *CSVFieldError) Unwrap() error { return e.Err }
+
+// CSVErrors collects every bad cell of an import.
+type CSVErrors []*CSVFieldError
+
+func (es CSVErrors) Error() string {
+	lines := make([]string, len(es))
+	for i, e := range es {
+		lines[i] = e.Error()
+	}
+	return fmt.Sprintf("money: %d invalid CSV fields:\n%s", len(es), strings.Join(lines, "\n"))
+}
+
+var (
+	amountType     = reflect.TypeOf(Amount(0))
+	nullAmountType = reflect.TypeOf(NullAmount{})
+)
+
+// csvColumn maps a header name to a struct field (`csv:"name"` tag).
+type csvColumn struct {
+	name  string
+	field int
+}
+
+func csvColumns(t reflect.Type) ([]csvColumn, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("money: CSV row type %s is not a struct", t)
+	}
+	var cols []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("csv")
+		if name == "" || name == "-" || !f.IsExported() {
+			continue
+		}
+		switch f.Type {
+		case amountType, nullAmountType:
+		default:
+			if f.Type.Kind() != reflect.String {
+				return nil, fmt.Errorf("money: CSV field %s has unsupported type %s", f.Name, f.Type)
+			}
+		}
+		cols = append(cols, csvColumn{name: name, field: i})
+	}
+	return cols, nil
+}
+
+// ReadCSV decodes CSV with a header row into dst, a *[]T where T is a struct whose
+// Amount, NullAmount and string fields carry `csv:"column"` tags. Blank cells are
+// NULL for NullAmount fields and errors for Amount fields.
+//
+// Bad cells, quoting errors and rows with the wrong number of fields do not stop the import: rows with
+// errors are skipped, all other rows are stored in dst, and every error is returned in CSVErrors.
+func ReadCSV(r io.Reader, dst any, opts CSVOptions) error {
+	pv := reflect.ValueOf(dst)
+	if pv.Kind() != reflect.Pointer || pv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("money: ReadCSV needs a pointer to a slice, got %T", dst)
+	}
+	slice := pv.Elem()
+	cols, err := csvColumns(slice.Type().Elem())
+	if err != nil {
+		return err
+	}
+	parse := opts.Parse
+	if parse == nil {
+		parse = ParseString
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1 // checked per row below, so one bad row does not end the import
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("money: reading CSV header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, h := range header {
+		index[strings.TrimSpace(strings.TrimPrefix(h, "\uFEFF"))] = i // Excel writes a BOM
+	}
+	for _, c := range cols {
+		if _, ok := index[c.name]; !ok {
+			return fmt.Errorf("money: CSV header missing column %q", c.name)
+		}
+	}
+
+	var errs CSVErrors
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			// e.g. a bare quote: report the row and keep reading
+			errs = append(errs, &CSVFieldError{Line: pe.Line, Err: pe.Err})
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("money: reading CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(rec) != len(header) {
+			errs = append(errs, &CSVFieldError{
+				Line:  line,
+				Value: strings.Join(rec, string(cr.Comma)),
+				Err:   fmt.Errorf("%w: got %d, want %d", csv.ErrFieldCount, len(rec), len(header)),
+			})
+			continue
+		}
+
+		row := reflect.New(slice.Type().Elem()).Elem()
+		ok := true
+		for _, c := range cols {
+			val := rec[index[c.name]]
+			f := row.Field(c.field)
+			var ferr error
+			switch f.Type() {
+			case amountType:
+				var a Amount
+				if a, ferr = parse(val); ferr == nil {
+					f.Set(reflect.ValueOf(a))
+				}
+			case nullAmountType:
+				if strings.TrimSpace(val) != "" {
+					var a Amount
+					if a, ferr = parse(val); ferr == nil {
+						f.Set(reflect.ValueOf(NullAmount{Amount: a, Valid: true}))
+					}
+				}
+			default:
+				f.SetString(val)
+			}
+			if ferr != nil {
+				errs = append(errs, &CSVFieldError{Line: line, Column: c.name, Value: val, Err: ferr})
+				ok = false
+			}
+		}
+		if ok {
+			slice.Set(reflect.Append(slice, row))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// WriteCSV writes src, a []T with the same `csv` tags as ReadCSV, with a header row.
+// Amounts are written with opts.Format; invalid NullAmounts are written as blank cells.
+func WriteCSV(w io.Writer, src any, opts CSVOptions) error {
+	sv := reflect.ValueOf(src)
+	if sv.Kind() != reflect.Slice {
+		return fmt.Errorf("money: WriteCSV needs a slice, got %T", src)
+	}
+	cols, err := csvColumns(sv.Type().Elem())
+	if err != nil {
+		return err
+	}
+	format := opts.Format
+	if format == nil {
+		format = Amount.StringFixed2
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+	rec := make([]string, len(cols))
+	for i, c := range cols {
+		rec[i] = c.name
+	}
+	if err := cw.Write(rec); err != nil {
+		return err
+	}
+	for i := 0; i < sv.Len(); i++ {
+		row := sv.Index(i)
+		for j, c := range cols {
+			switch f := row.Field(c.field).Interface().(type) {
+			case Amount:
+				rec[j] = format(f)
+			case NullAmount:
+				rec[j] = ""
+				if f.Valid {
+					rec[j] = format(f.Amount)
+				}
+			default:
+				rec[j] = row.Field(c.field).String()
+			}
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
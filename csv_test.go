@@ -0,0 +1,191 @@
+package money_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+type priceChange struct {
+	SKU       string           `csv:"sku"`
+	Price     money.Amount     `csv:"fiyat"`
+	SalePrice money.NullAmount `csv:"indirimli_fiyat"`
+	Ignored   string
+}
+
+func TestReadCSV_Turkish(t *testing.T) {
+	in := "\uFEFFsku;fiyat;indirimli_fiyat;not\n" +
+		"A-1;1.234,56;999,90;x\n" +
+		"A-2;₺12,50;;\n"
+
+	var rows []priceChange
+	err := money.ReadCSV(strings.NewReader(in), &rows, money.CSVOptions{
+		Comma: ';',
+		Parse: money.LocaleParser(money.ParseTR),
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("len(rows)=%d want=2", len(rows))
+	}
+	if rows[0].SKU != "A-1" || rows[0].Price.Minor() != 123456 || !rows[0].SalePrice.Valid || rows[0].SalePrice.Amount.Minor() != 99990 {
+		t.Fatalf("row 0 got=%+v", rows[0])
+	}
+	if rows[1].Price.Minor() != 1250 || rows[1].SalePrice.Valid {
+		t.Fatalf("row 1 got=%+v", rows[1])
+	}
+}
+
+func TestReadCSV_CollectsAllErrors(t *testing.T) {
+	in := "sku,fiyat,indirimli_fiyat\n" +
+		"A-1,12.34,\n" +
+		"A-2,12.345,abc\n" +
+		"A-3,,1.00\n" +
+		"A-4,5.00,4.00\n"
+
+	var rows []priceChange
+	err := money.ReadCSV(strings.NewReader(in), &rows, money.CSVOptions{})
+
+	var errs money.CSVErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected CSVErrors, got=%v", err)
+	}
+	if len(errs) != 3 {
+		t.Fatalf("len(errs)=%d want=3: %v", len(errs), err)
+	}
+	want := []struct {
+		line   int
+		column string
+	}{
+		{3, "fiyat"},
+		{3, "indirimli_fiyat"},
+		{4, "fiyat"},
+	}
+	for i, w := range want {
+		if errs[i].Line != w.line || errs[i].Column != w.column {
+			t.Fatalf("errs[%d] got=(%d,%q) want=(%d,%q)", i, errs[i].Line, errs[i].Column, w.line, w.column)
+		}
+	}
+	if !strings.Contains(err.Error(), `line 3, column "fiyat", value "12.345"`) {
+		t.Fatalf("unhelpful message: %v", err)
+	}
+
+	// valid rows are still imported
+	if len(rows) != 2 || rows[0].SKU != "A-1" || rows[1].SKU != "A-4" {
+		t.Fatalf("rows got=%+v", rows)
+	}
+}
+
+func TestReadCSV_WrongFieldCount(t *testing.T) {
+	in := "sku,fiyat,indirimli_fiyat\n" +
+		"A-1,12.34,\n" +
+		"A-2,12.34\n" +
+		"A-3,1.00,2.00,extra\n" +
+		"A-4,5.00,4.00\n"
+
+	var rows []priceChange
+	err := money.ReadCSV(strings.NewReader(in), &rows, money.CSVOptions{})
+
+	var errs money.CSVErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected CSVErrors, got=%v", err)
+	}
+	if len(errs) != 2 || errs[0].Line != 3 || errs[1].Line != 4 || errs[0].Column != "" {
+		t.Fatalf("errs got=%v", err)
+	}
+	if !errors.Is(errs[0], csv.ErrFieldCount) {
+		t.Fatalf("expected csv.ErrFieldCount, got=%v", errs[0])
+	}
+	if !strings.Contains(err.Error(), "line 3: wrong number of fields: got 2, want 3") {
+		t.Fatalf("unhelpful message: %v", err)
+	}
+
+	// rows after the bad ones are still imported
+	if len(rows) != 2 || rows[0].SKU != "A-1" || rows[1].SKU != "A-4" {
+		t.Fatalf("rows got=%+v", rows)
+	}
+}
+
+func TestReadCSV_BadQuote(t *testing.T) {
+	in := "sku,fiyat,indirimli_fiyat\n" +
+		"A-1,12.345,\n" +
+		"A-2,1.00,\n" +
+		"A\"3,2.00,\n" +
+		"A-4,3.00,\n"
+
+	var rows []priceChange
+	err := money.ReadCSV(strings.NewReader(in), &rows, money.CSVOptions{})
+
+	var errs money.CSVErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected CSVErrors, got=%v", err)
+	}
+	if len(errs) != 2 || errs[0].Line != 2 || errs[0].Column != "fiyat" || errs[1].Line != 4 || errs[1].Column != "" {
+		t.Fatalf("errs got=%v", err)
+	}
+	if !errors.Is(errs[1], csv.ErrBareQuote) {
+		t.Fatalf("expected csv.ErrBareQuote, got=%v", errs[1])
+	}
+
+	// rows after the bad quote are still read
+	if len(rows) != 2 || rows[0].SKU != "A-2" || rows[1].SKU != "A-4" {
+		t.Fatalf("rows got=%+v", rows)
+	}
+}
+
+func TestReadCSV_SetupErrors(t *testing.T) {
+	var rows []priceChange
+	if err := money.ReadCSV(strings.NewReader("sku,fiyat\nA,1\n"), &rows, money.CSVOptions{}); err == nil {
+		t.Fatalf("expected error for missing column")
+	}
+	if err := money.ReadCSV(strings.NewReader(""), &rows, money.CSVOptions{}); err == nil {
+		t.Fatalf("expected error for missing header")
+	}
+	if err := money.ReadCSV(strings.NewReader("sku\n"), rows, money.CSVOptions{}); err == nil {
+		t.Fatalf("expected error for non-pointer dst")
+	}
+
+	type bad struct {
+		N int `csv:"n"`
+	}
+	var bads []bad
+	if err := money.ReadCSV(strings.NewReader("n\n1\n"), &bads, money.CSVOptions{}); err == nil {
+		t.Fatalf("expected error for unsupported field type")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	rows := []priceChange{
+		{SKU: "A-1", Price: money.NewMinor(123456), SalePrice: money.NullAmount{Amount: money.NewMinor(99990), Valid: true}},
+		{SKU: "A-2", Price: money.NewMinor(-500)},
+	}
+
+	var b strings.Builder
+	tr := money.FormatOptions{Decimal: ',', Group: '.'}
+	err := money.WriteCSV(&b, rows, money.CSVOptions{
+		Comma:  ';',
+		Format: func(a money.Amount) string { return a.FormatLocale(tr) },
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	want := "sku;fiyat;indirimli_fiyat\n" +
+		"A-1;1.234,56;999,90\n" +
+		"A-2;-5,00;\n"
+	if b.String() != want {
+		t.Fatalf("got=%q\nwant=%q", b.String(), want)
+	}
+
+	// round-trip through ReadCSV
+	var back []priceChange
+	if err := money.ReadCSV(strings.NewReader(b.String()), &back, money.CSVOptions{Comma: ';', Parse: money.LocaleParser(money.ParseTR)}); err != nil {
+		t.Fatalf("round-trip err: %v", err)
+	}
+	if len(back) != 2 || back[0].Price != rows[0].Price || back[1].SalePrice.Valid {
+		t.Fatalf("round-trip got=%+v", back)
+	}
+}
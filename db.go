@@ -3,59 +3,68 @@ package money
 import (
 	"database/sql/driver"
 	"fmt"
-	"strconv"
 )
 
+// DBAmount wraps Amount for DECIMAL(10,2) columns.
+// Amount implements sql.Scanner and driver.Valuer itself with the same semantics;
+// DBAmount is kept for compatibility.
 type DBAmount struct {
 	A Amount
 }
 
 func (m *DBAmount) Scan(value any) error {
+	return m.A.Scan(value)
+}
+
+func (m DBAmount) Value() (driver.Value, error) {
+	return m.A.Value()
+}
+
+// Scan implements sql.Scanner. NULL scans as 0; use NullAmount to keep NULL.
+func (a *Amount) Scan(value any) error {
 	if value == nil {
-		m.A = 0
+		*a = 0
 		return nil
 	}
 
 	switch v := value.(type) {
 	case []byte:
-		a, err := ParseString(string(v))
+		parsed, err := ParseString(string(v))
 		if err != nil {
 			return err
 		}
-		m.A = a
+		*a = parsed
 		return nil
 
 	case string:
-		a, err := ParseString(v)
+		parsed, err := ParseString(v)
 		if err != nil {
 			return err
 		}
-		m.A = a
+		*a = parsed
 		return nil
 
 	case float64:
-		// Force 2 decimals, then parse strictly.
-		s := strconv.FormatFloat(v, 'f', 2, 64)
-		a, err := ParseString(s)
+		// See FloatScan / SetFloatScan for strict and explicit-rounding modes.
+		parsed, err := defaultFloatScan().Convert(v, 64)
 		if err != nil {
 			return err
 		}
-		m.A = a
+		*a = parsed
 		return nil
 
 	case float32:
-		s := strconv.FormatFloat(float64(v), 'f', 2, 64)
-		a, err := ParseString(s)
+		parsed, err := defaultFloatScan().Convert(float64(v), 32)
 		if err != nil {
 			return err
 		}
-		m.A = a
+		*a = parsed
 		return nil
 
 	case int64:
 		// If driver returns integer, assume it's already minor? (ambiguous)
 		// Better to treat as major units without decimals is dangerous.
-		// If you WANT to support this, define it clearly. For safety, reject:
+		// For safety, reject; BIGINT minor-unit columns should use DBMinor.
 		return fmt.Errorf("money: unsupported scan int64=%d (ambiguous units)", v)
 
 	default:
@@ -63,6 +72,7 @@ func (m *DBAmount) Scan(value any) error {
 	}
 }
 
-func (m DBAmount) Value() (driver.Value, error) {
-	return m.A.StringFixed2(), nil
+// Value implements driver.Valuer: "12.34".
+func (a Amount) Value() (driver.Value, error) {
+	return a.StringFixed2(), nil
 }
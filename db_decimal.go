@@ -0,0 +1,154 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DecimalSpec describes a DECIMAL(Precision, Scale) column.
+type DecimalSpec struct {
+	Precision int // total digits
+	Scale     int // fractional digits
+}
+
+// Decimal returns the spec for DECIMAL(precision, scale).
+func Decimal(precision, scale int) DecimalSpec {
+	return DecimalSpec{Precision: precision, Scale: scale}
+}
+
+func (s DecimalSpec) Validate() error {
+	if s.Precision < 1 || s.Precision > 65 || s.Scale < 0 || s.Scale > s.Precision {
+		return fmt.Errorf("money: invalid DECIMAL(%d,%d)", s.Precision, s.Scale)
+	}
+	return nil
+}
+
+// Fits reports an error if a cannot be stored in the column without loss.
+func (s DecimalSpec) Fits(a Amount) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	mag := uint64(a)
+	if a < 0 {
+		mag = -mag
+	}
+	if s.Scale < 2 {
+		unit := uint64(1)
+		for i := s.Scale; i < 2; i++ {
+			unit *= 10
+		}
+		if mag%unit != 0 {
+			return fmt.Errorf("money: %s does not fit DECIMAL(%d,%d) scale", a.StringFixed2(), s.Precision, s.Scale)
+		}
+	}
+	whole := mag / 100
+	digits := 0
+	for ; whole > 0; whole /= 10 {
+		digits++
+	}
+	if digits > s.Precision-s.Scale {
+		return fmt.Errorf("money: %s overflows DECIMAL(%d,%d)", a.StringFixed2(), s.Precision, s.Scale)
+	}
+	return nil
+}
+
+// Format renders a with exactly Scale fractional digits ("12.3400" for scale 4).
+func (s DecimalSpec) Format(a Amount) (string, error) {
+	if err := s.Fits(a); err != nil {
+		return "", err
+	}
+	out := a.StringFixed2()
+	switch {
+	case s.Scale == 0:
+		out = out[:len(out)-3]
+	case s.Scale == 1:
+		out = out[:len(out)-1]
+	default:
+		out += strings.Repeat("0", s.Scale-2)
+	}
+	return out, nil
+}
+
+// Parse parses a column value, rejecting values that would lose precision
+// (non-zero digits beyond 2 decimals) or do not fit the column.
+func (s DecimalSpec) Parse(v string) (Amount, error) {
+	str, err := trimZeroFrac(v)
+	if err != nil {
+		return 0, err
+	}
+	a, err := ParseString(str)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Fits(a); err != nil {
+		return 0, err
+	}
+	return a, nil
+}
+
+// trimZeroFrac drops fractional digits beyond the second if they are all zero:
+// "12.3400" -> "12.34", "12.3401" -> error.
+func trimZeroFrac(v string) (string, error) {
+	v = strings.TrimSpace(v)
+	dot := strings.IndexByte(v, '.')
+	if dot < 0 || len(v)-dot-1 <= 2 {
+		return v, nil
+	}
+	extra := v[dot+3:]
+	if strings.Trim(extra, "0") != "" {
+		return "", fmt.Errorf("money: %q has more than 2 significant decimal places", v)
+	}
+	return v[:dot+3], nil
+}
+
+// DBDecimal is a DBAmount for a DECIMAL column with an explicit spec.
+// Set Spec before scanning:
+//
+//	p := money.DBDecimal{Spec: money.Decimal(19, 4)}
+//	err := row.Scan(&p)
+type DBDecimal struct {
+	A    Amount
+	Spec DecimalSpec
+}
+
+func (m *DBDecimal) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case int64:
+		return m.scanString(strconv.FormatInt(v, 10))
+	case float64:
+		// shortest form, so 12.345 is rejected instead of silently rounded
+		return m.scanString(strconv.FormatFloat(v, 'f', -1, 64))
+	case float32:
+		return m.scanString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	default:
+		var db DBAmount
+		if err := db.Scan(value); err != nil {
+			return err
+		}
+		if err := m.Spec.Fits(db.A); err != nil {
+			return err
+		}
+		m.A = db.A
+		return nil
+	}
+}
+
+func (m *DBDecimal) scanString(s string) error {
+	a, err := m.Spec.Parse(s)
+	if err != nil {
+		return err
+	}
+	m.A = a
+	return nil
+}
+
+// Value rejects amounts that do not fit the column before they reach the driver.
+func (m DBDecimal) Value() (driver.Value, error) {
+	return m.Spec.Format(m.A)
+}
@@ -0,0 +1,114 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestDecimalSpec_Format(t *testing.T) {
+	cases := []struct {
+		spec  money.DecimalSpec
+		minor int64
+		want  string
+	}{
+		{money.Decimal(10, 2), 1234, "12.34"},
+		{money.Decimal(10, 2), 9999999999, "99999999.99"},
+		{money.Decimal(19, 4), 1234, "12.3400"},
+		{money.Decimal(19, 4), -5, "-0.0500"},
+		{money.Decimal(12, 0), 120000, "1200"},
+		{money.Decimal(5, 1), 1230, "12.3"},
+		{money.Decimal(2, 2), 99, "0.99"},
+	}
+	for _, tc := range cases {
+		got, err := tc.spec.Format(money.NewMinor(tc.minor))
+		if err != nil {
+			t.Fatalf("spec=%v minor=%d err: %v", tc.spec, tc.minor, err)
+		}
+		if got != tc.want {
+			t.Fatalf("spec=%v minor=%d got=%q want=%q", tc.spec, tc.minor, got, tc.want)
+		}
+	}
+}
+
+func TestDecimalSpec_Fits_Errors(t *testing.T) {
+	cases := []struct {
+		spec  money.DecimalSpec
+		minor int64
+	}{
+		{money.Decimal(10, 2), 10000000000},  // 100,000,000.00
+		{money.Decimal(10, 2), -10000000000}, // negative overflow
+		{money.Decimal(12, 0), 1234},         // 12.34 into scale 0
+		{money.Decimal(5, 1), 1234},          // 12.34 into scale 1
+		{money.Decimal(2, 2), 100},           // 1.00 into DECIMAL(2,2)
+		{money.Decimal(0, 0), 0},             // invalid spec
+		{money.Decimal(4, 5), 0},             // invalid spec
+	}
+	for _, tc := range cases {
+		if err := tc.spec.Fits(money.NewMinor(tc.minor)); err == nil {
+			t.Fatalf("spec=%v minor=%d expected error", tc.spec, tc.minor)
+		}
+	}
+}
+
+func TestDBDecimal_Scan(t *testing.T) {
+	cases := []struct {
+		spec money.DecimalSpec
+		in   any
+		want int64
+	}{
+		{money.Decimal(19, 4), []byte("12.3400"), 1234},
+		{money.Decimal(19, 4), "-0.0500", -5},
+		{money.Decimal(10, 2), "99999999.99", 9999999999},
+		{money.Decimal(12, 0), []byte("1200"), 120000},
+		{money.Decimal(12, 0), int64(1200), 120000},
+		{money.Decimal(19, 4), float64(12.34), 1234},
+		{money.Decimal(19, 4), float32(0.5), 50},
+		{money.Decimal(10, 2), nil, 0},
+	}
+	for _, tc := range cases {
+		m := money.DBDecimal{Spec: tc.spec}
+		if err := m.Scan(tc.in); err != nil {
+			t.Fatalf("spec=%v in=%v err: %v", tc.spec, tc.in, err)
+		}
+		if got := m.A.Minor(); got != tc.want {
+			t.Fatalf("spec=%v in=%v got=%d want=%d", tc.spec, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestDBDecimal_Scan_Errors(t *testing.T) {
+	cases := []struct {
+		spec money.DecimalSpec
+		in   any
+	}{
+		{money.Decimal(19, 4), "12.3456"},      // loses precision
+		{money.Decimal(10, 2), "100000000.00"}, // does not fit column
+		{money.DecimalSpec{}, "12.34"},         // spec not set
+		{money.Decimal(10, 2), true},
+		{money.Decimal(19, 4), float64(12.345)}, // float loses precision
+		{money.Decimal(19, 4), float64(0.125)},
+		{money.Decimal(19, 4), math.NaN()},
+	}
+	for _, tc := range cases {
+		m := money.DBDecimal{Spec: tc.spec}
+		if err := m.Scan(tc.in); err == nil {
+			t.Fatalf("spec=%v in=%v expected error", tc.spec, tc.in)
+		}
+	}
+}
+
+func TestDBDecimal_Value(t *testing.T) {
+	v, err := money.DBDecimal{A: money.NewMinor(1234), Spec: money.Decimal(19, 4)}.Value()
+	if err != nil {
+		t.Fatalf("value err: %v", err)
+	}
+	if v.(string) != "12.3400" {
+		t.Fatalf("got=%v want=%q", v, "12.3400")
+	}
+
+	if _, err := (money.DBDecimal{A: money.NewMinor(10000000000), Spec: money.Decimal(10, 2)}).Value(); err == nil {
+		t.Fatalf("expected overflow error before reaching the driver")
+	}
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// DBMinor stores amounts as integer minor units in BIGINT columns.
+// 12.34 TL <=> 1234
+type DBMinor struct {
+	A Amount
+}
+
+func (m *DBMinor) Scan(value any) error {
+	if value == nil {
+		m.A = 0
+		return nil
+	}
+
+	switch v := value.(type) {
+	case int64:
+		m.A = Amount(v)
+		return nil
+
+	case []byte:
+		return m.scanString(string(v))
+
+	case string:
+		return m.scanString(v)
+
+	default:
+		// float64 etc.: a BIGINT column never needs them, so reject rather than guess.
+		return fmt.Errorf("money: unsupported minor-unit scan type %T", value)
+	}
+}
+
+func (m *DBMinor) scanString(s string) error {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("money: invalid minor units %q: %w", s, err)
+	}
+	m.A = Amount(n)
+	return nil
+}
+
+func (m DBMinor) Value() (driver.Value, error) {
+	return int64(m.A), nil
+}
@@ -0,0 +1,48 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestDBMinor_Scan(t *testing.T) {
+	cases := []struct {
+		in   any
+		want int64
+	}{
+		{int64(1234), 1234},
+		{int64(-50), -50},
+		{[]byte("1234"), 1234},
+		{"-9223372036854775808", -9223372036854775808},
+		{nil, 0},
+	}
+	for _, tc := range cases {
+		var m money.DBMinor
+		if err := m.Scan(tc.in); err != nil {
+			t.Fatalf("Scan(%v) err: %v", tc.in, err)
+		}
+		if got := m.A.Minor(); got != tc.want {
+			t.Fatalf("Scan(%v) got=%d want=%d", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestDBMinor_Scan_Errors(t *testing.T) {
+	for _, in := range []any{"12.34", []byte("1e3"), "9223372036854775808", 12.34, true} {
+		var m money.DBMinor
+		if err := m.Scan(in); err == nil {
+			t.Fatalf("Scan(%v) expected error", in)
+		}
+	}
+}
+
+func TestDBMinor_Value(t *testing.T) {
+	v, err := money.DBMinor{A: money.NewMinor(1234)}.Value()
+	if err != nil {
+		t.Fatalf("value err: %v", err)
+	}
+	if v.(int64) != 1234 {
+		t.Fatalf("got=%v want=1234", v)
+	}
+}
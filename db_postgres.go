@@ -0,0 +1,198 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PGNumeric scans PostgreSQL NUMERIC text as returned by lib/pq and pgx ("12.34", "12.3400"),
+// exponent form ("1234e-2"), int64 (whole units) and float64.
+// NaN and ±Infinity are rejected.
+type PGNumeric struct {
+	A Amount
+}
+
+func (m *PGNumeric) Scan(value any) error {
+	if value == nil {
+		m.A = 0
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case int64:
+		// NUMERIC integers are whole units: 12 => 12.00
+		if v > math.MaxInt64/100 || v < math.MinInt64/100 {
+			return fmt.Errorf("money: overflow: %d", v)
+		}
+		m.A = Amount(v * 100)
+		return nil
+	case float64:
+		a, err := defaultFloatScan().Convert(v, 64)
+		if err != nil {
+			return err
+		}
+		m.A = a
+		return nil
+	default:
+		return fmt.Errorf("money: unsupported scan type %T", value)
+	}
+}
+
+func (m *PGNumeric) scanString(s string) error {
+	a, err := parseNumeric(s)
+	if err != nil {
+		return err
+	}
+	m.A = a
+	return nil
+}
+
+func (m PGNumeric) Value() (driver.Value, error) {
+	return m.A.StringFixed2(), nil
+}
+
+// parseNumeric parses PostgreSQL NUMERIC text, including exponent form.
+func parseNumeric(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(strings.TrimLeft(s, "+-")) {
+	case "nan", "infinity", "inf":
+		return 0, fmt.Errorf("money: non-finite numeric %q", s)
+	}
+
+	mant, exp, hasExp := strings.Cut(strings.ToLower(s), "e")
+	if strings.IndexFunc(mant, isDigit) < 0 {
+		// "", ".", "e2" or ".e2" must not read as zero
+		return 0, fmt.Errorf("money: invalid numeric %q", s)
+	}
+	if !hasExp {
+		str, err := trimZeroFrac(s)
+		if err != nil {
+			return 0, err
+		}
+		return ParseString(str)
+	}
+
+	e, err := strconv.Atoi(exp)
+	if err != nil || e < -40 || e > 40 {
+		return 0, fmt.Errorf("money: invalid numeric exponent: %q", s)
+	}
+	sign := ""
+	if mant != "" && (mant[0] == '-' || mant[0] == '+') {
+		sign, mant = mant[:1], mant[1:]
+	}
+	whole, frac, _ := strings.Cut(mant, ".")
+	digits := whole + frac
+	point := len(whole) + e // position of the decimal point within digits
+	switch {
+	case point < 0:
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	case point > len(digits):
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	str, err := trimZeroFrac(sign + digits[:point] + "." + digits[point:])
+	if err != nil {
+		return 0, err
+	}
+	return ParseString(str)
+}
+
+// PGMoney scans PostgreSQL `money` values rendered with any lc_monetary:
+// "$1,234.56", "-$1,234.56", "($1,234.56)", "1.234,56 ₺", "-1.234,56 ₺".
+// The decimal separator is the last '.' or ',' when followed by exactly 2 digits.
+//
+// Value writes "12.34"; cast through numeric in SQL ($1::numeric::money) so the
+// server's lc_monetary cannot misread it.
+type PGMoney struct {
+	A Amount
+}
+
+func (m *PGMoney) Scan(value any) error {
+	if value == nil {
+		m.A = 0
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return fmt.Errorf("money: unsupported scan type %T", value)
+	}
+	a, err := parsePGMoney(s)
+	if err != nil {
+		return err
+	}
+	m.A = a
+	return nil
+}
+
+func (m PGMoney) Value() (driver.Value, error) {
+	return m.A.StringFixed2(), nil
+}
+
+func parsePGMoney(in string) (Amount, error) {
+	s := strings.TrimSpace(in)
+	neg := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		neg, s = true, s[1:len(s)-1]
+	}
+
+	first := strings.IndexFunc(s, isDigit)
+	last := strings.LastIndexFunc(s, isDigit)
+	if first < 0 {
+		return 0, fmt.Errorf("money: invalid money value %q", in)
+	}
+	// Anything outside the digits is currency symbol, sign or space.
+	for _, affix := range []string{s[:first], s[last+1:]} {
+		for _, r := range affix {
+			if r == '-' {
+				if neg {
+					return 0, fmt.Errorf("money: invalid money value %q", in)
+				}
+				neg = true
+			} else if isDigit(r) || r == '.' || r == ',' {
+				return 0, fmt.Errorf("money: invalid money value %q", in)
+			}
+		}
+	}
+	core := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1 // space grouping (e.g. fr_FR)
+		}
+		return r
+	}, s[first:last+1])
+
+	opts := ParseOptions{Decimal: '.', Group: ','}
+	if i := strings.LastIndexAny(core, ".,"); i >= 0 {
+		sep := rune(core[i])
+		other := ','
+		if sep == ',' {
+			other = '.'
+		}
+		if len(core)-i-1 == 2 {
+			opts = ParseOptions{Decimal: sep, Group: other}
+		} else {
+			opts = ParseOptions{Decimal: other, Group: sep}
+		}
+	}
+
+	// Sign goes in before parsing so the minimum, -92,233,720,368,547,758.08, fits.
+	if neg {
+		core = "-" + core
+	}
+	return ParseLocale(core, opts)
+}
+
+func isDigit(r rune) bool { return r >= '0' && r <= '9' }
@@ -0,0 +1,214 @@
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AmountSlice scans PostgreSQL arrays ("{12.34,5.00}", e.g. numeric[]) and JSON arrays
+// (`["12.34","5.00"]` or `[12.34,5]`, e.g. MySQL JSON columns). Every element goes through ParseString;
+// errors report the index of the failing element.
+//
+// Value writes a PostgreSQL array literal. For JSON columns, write json.Marshal(s) instead.
+type AmountSlice []Amount
+
+func (s *AmountSlice) Scan(value any) error {
+	text, err := scanText(value)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(text)
+	var elems []string
+	switch {
+	case strings.HasPrefix(trimmed, "{"):
+		elems, err = parsePGArray(trimmed)
+	case strings.HasPrefix(trimmed, "["):
+		elems, err = parseJSONArray(trimmed)
+	default:
+		err = fmt.Errorf("money: unsupported array format: %q", text)
+	}
+	if err != nil {
+		return err
+	}
+
+	out := make(AmountSlice, len(elems))
+	for i, e := range elems {
+		a, err := ParseString(e)
+		if err != nil {
+			return fmt.Errorf("money: element %d: %w", i, err)
+		}
+		out[i] = a
+	}
+	*s = out
+	return nil
+}
+
+func (s AmountSlice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, a := range s {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(a.StringFixed2())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// AmountMap scans JSON objects such as {"1":"100.00","3":"34.00"} (e.g. installment schedules).
+// Errors report the key of the failing element. Value writes the same JSON form.
+type AmountMap map[string]Amount
+
+func (m *AmountMap) Scan(value any) error {
+	text, err := scanText(value)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		*m = nil
+		return nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(text), &raw); err != nil {
+		return fmt.Errorf("money: invalid JSON object: %w", err)
+	}
+	out := make(AmountMap, len(raw))
+	for k, v := range raw {
+		s, err := jsonElement(v)
+		if err != nil {
+			return fmt.Errorf("money: key %q: %w", k, err)
+		}
+		a, err := ParseString(s)
+		if err != nil {
+			return fmt.Errorf("money: key %q: %w", k, err)
+		}
+		out[k] = a
+	}
+	*m = out
+	return nil
+}
+
+func (m AmountMap) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(map[string]Amount(m))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func scanText(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case []byte:
+		return string(v), nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("money: unsupported scan type %T", value)
+	}
+}
+
+// parsePGArray splits a one-dimensional PostgreSQL array literal into element texts.
+func parsePGArray(s string) ([]string, error) {
+	if !strings.HasSuffix(s, "}") {
+		return nil, fmt.Errorf("money: invalid array literal: %q", s)
+	}
+	body := s[1 : len(s)-1]
+	if strings.TrimSpace(body) == "" {
+		return []string{}, nil
+	}
+
+	var out []string
+	var cur strings.Builder
+	quoted, inQuotes := false, false
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case inQuotes && c == '\\' && i+1 < len(body):
+			i++
+			cur.WriteByte(body[i])
+		case c == '"':
+			inQuotes, quoted = !inQuotes, true
+		case inQuotes:
+			cur.WriteByte(c)
+		case c == '{' || c == '}':
+			return nil, fmt.Errorf("money: multi-dimensional arrays are not supported: %q", s)
+		case c == ',':
+			if err := pgElement(&out, cur.String(), quoted); err != nil {
+				return nil, err
+			}
+			cur.Reset()
+			quoted = false
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("money: unterminated quote in array literal: %q", s)
+	}
+	if err := pgElement(&out, cur.String(), quoted); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func pgElement(out *[]string, e string, quoted bool) error {
+	if !quoted {
+		e = strings.TrimSpace(e)
+		if strings.EqualFold(e, "NULL") {
+			return fmt.Errorf("money: element %d: NULL element", len(*out))
+		}
+	}
+	*out = append(*out, e)
+	return nil
+}
+
+func parseJSONArray(s string) ([]string, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("money: invalid JSON array: %w", err)
+	}
+	out := make([]string, len(raw))
+	for i, r := range raw {
+		e, err := jsonElement(r)
+		if err != nil {
+			return nil, fmt.Errorf("money: element %d: %w", i, err)
+		}
+		out[i] = e
+	}
+	return out, nil
+}
+
+// jsonElement returns the text of a JSON string or number (never via float64).
+func jsonElement(r json.RawMessage) (string, error) {
+	r = bytes.TrimSpace(r)
+	if len(r) > 0 && r[0] == '"' {
+		var s string
+		if err := json.Unmarshal(r, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	n, err := jsonNumber(r)
+	if err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
@@ -0,0 +1,108 @@
+package money_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmountSlice_Scan(t *testing.T) {
+	cases := []struct {
+		in   any
+		want []int64
+	}{
+		{[]byte("{12.34,5.00,-0.50}"), []int64{1234, 500, -50}},
+		{"{}", []int64{}},
+		{`{"12.34", 5}`, []int64{1234, 500}},
+		{[]byte(`["12.34","5.00"]`), []int64{1234, 500}},
+		{`[12.34, 5]`, []int64{1234, 500}},
+		{`[]`, []int64{}},
+	}
+	for _, tc := range cases {
+		var s money.AmountSlice
+		if err := s.Scan(tc.in); err != nil {
+			t.Fatalf("Scan(%s) err: %v", tc.in, err)
+		}
+		if len(s) != len(tc.want) {
+			t.Fatalf("Scan(%s) len=%d want=%d", tc.in, len(s), len(tc.want))
+		}
+		for i := range s {
+			if s[i].Minor() != tc.want[i] {
+				t.Fatalf("Scan(%s)[%d] got=%d want=%d", tc.in, i, s[i].Minor(), tc.want[i])
+			}
+		}
+	}
+
+	var s money.AmountSlice = money.AmountSlice{1}
+	if err := s.Scan(nil); err != nil || s != nil {
+		t.Fatalf("Scan(nil) got=%v err=%v", s, err)
+	}
+}
+
+func TestAmountSlice_Scan_ErrorIndex(t *testing.T) {
+	cases := []struct {
+		in      string
+		wantErr string
+	}{
+		{"{12.34,5.001,1}", "element 1"},
+		{"{1,2,NULL}", "element 2"},
+		{`["1.00","2.00","x"]`, "element 2"},
+		{`[1, 2.345]`, "element 1"},
+		{`[1, 1e3]`, "element 1"},
+		{`[1, null]`, "element 1"},
+		{"{{1,2},{3,4}}", "multi-dimensional"},
+		{`{"1.00}`, "unterminated"},
+		{"12.34", "unsupported array format"},
+	}
+	for _, tc := range cases {
+		var s money.AmountSlice
+		err := s.Scan(tc.in)
+		if err == nil {
+			t.Fatalf("Scan(%s) expected error", tc.in)
+		}
+		if !strings.Contains(err.Error(), tc.wantErr) {
+			t.Fatalf("Scan(%s) err=%q want contains %q", tc.in, err, tc.wantErr)
+		}
+	}
+}
+
+func TestAmountSlice_Value(t *testing.T) {
+	v, err := money.AmountSlice{1234, -50}.Value()
+	if err != nil || v.(string) != "{12.34,-0.50}" {
+		t.Fatalf("got=%v err=%v", v, err)
+	}
+	v, err = money.AmountSlice(nil).Value()
+	if err != nil || v != nil {
+		t.Fatalf("nil slice got=%v err=%v want nil", v, err)
+	}
+
+	// round-trip
+	var back money.AmountSlice
+	if err := back.Scan("{12.34,-0.50}"); err != nil || len(back) != 2 || back[1] != -50 {
+		t.Fatalf("round-trip got=%v err=%v", back, err)
+	}
+}
+
+func TestAmountMap(t *testing.T) {
+	var m money.AmountMap
+	if err := m.Scan([]byte(`{"1":"100.00","3":34}`)); err != nil {
+		t.Fatalf("scan err: %v", err)
+	}
+	if len(m) != 2 || m["1"].Minor() != 10000 || m["3"].Minor() != 3400 {
+		t.Fatalf("got=%v", m)
+	}
+
+	v, err := m.Value()
+	if err != nil || v.(string) != `{"1":"100.00","3":"34.00"}` {
+		t.Fatalf("got=%v err=%v", v, err)
+	}
+
+	err = m.Scan(`{"1":"100.00","6":"1.234"}`)
+	if err == nil || !strings.Contains(err.Error(), `key "6"`) {
+		t.Fatalf("expected key error, got=%v", err)
+	}
+	if err := m.Scan(`["1.00"]`); err == nil {
+		t.Fatalf("expected error for array")
+	}
+}
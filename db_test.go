@@ -1,6 +1,8 @@
 package money_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"testing"
 
 	"github.com/dahaiyiyimcom/money"
@@ -120,3 +122,55 @@ func TestDBAmount_Scan_UnsupportedType(t *testing.T) {
 		t.Fatalf("expected error for unsupported type")
 	}
 }
+
+func TestAmount_SQLInterfaces(t *testing.T) {
+	var (
+		_ sql.Scanner   = (*money.Amount)(nil)
+		_ driver.Valuer = money.Amount(0)
+	)
+
+	var a money.Amount
+	if err := a.Scan([]byte("12.34")); err != nil {
+		t.Fatalf("scan err: %v", err)
+	}
+	if a.Minor() != 1234 {
+		t.Fatalf("got=%d want=1234", a.Minor())
+	}
+
+	v, err := a.Value()
+	if err != nil || v.(string) != "12.34" {
+		t.Fatalf("got=%v err=%v want=%q", v, err, "12.34")
+	}
+
+	if err := a.Scan(int64(1234)); err == nil {
+		t.Fatalf("expected error for int64 scan")
+	}
+	if a.Minor() != 1234 {
+		t.Fatalf("failed scan must not modify value, got=%d", a.Minor())
+	}
+}
+
+func TestAmount_MatchesDBAmount(t *testing.T) {
+	inputs := []any{nil, "419.29", []byte("-0.50"), 12.34, float32(12.34), "12.345", int64(1), true}
+	for _, in := range inputs {
+		var a money.Amount
+		var db money.DBAmount
+		errA, errDB := a.Scan(in), db.Scan(in)
+		if (errA == nil) != (errDB == nil) || a != db.A {
+			t.Fatalf("in=%v Amount=(%d,%v) DBAmount=(%d,%v)", in, a.Minor(), errA, db.A.Minor(), errDB)
+		}
+	}
+}
+
+func TestSQLNull_Amount(t *testing.T) {
+	var n sql.Null[money.Amount]
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Fatalf("Scan(nil) got=%+v err=%v", n, err)
+	}
+	if err := n.Scan("9.99"); err != nil {
+		t.Fatalf("scan err: %v", err)
+	}
+	if !n.Valid || n.V.Minor() != 999 {
+		t.Fatalf("got=%+v want valid 999", n)
+	}
+}
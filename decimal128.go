@@ -0,0 +1,88 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// IEEE 754-2008 decimal128, binary integer decimal (BID) encoding, as used by
+// MongoDB Decimal128 (bson primitive.Decimal128 GetBytes / NewDecimal128 high, low).
+//
+//	high: sign(1) | biased exponent(14) | coefficient bits 112..64 (49)
+//	low:  coefficient bits 63..0
+//
+// Special forms start with the combination bits 11 after the sign:
+// 11110 = Infinity, 11111 = NaN, otherwise a (non-canonical) large coefficient.
+
+const (
+	d128Bias        = 6176
+	d128SignBit     = uint64(1) << 63
+	d128CoeffHiMask = uint64(1)<<49 - 1
+)
+
+var d128MaxCoeff = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(34), nil), big.NewInt(1))
+
+// Decimal128 returns the BID encoding of the amount with exponent -2: 12.34 => 1234E-2.
+func (a Amount) Decimal128() (high, low uint64) {
+	mag := uint64(a)
+	if a < 0 {
+		mag = -mag
+		high = d128SignBit
+	}
+	high |= uint64(d128Bias-2) << 49
+	return high, mag
+}
+
+// FromDecimal128 converts a BID-encoded decimal128 to an Amount.
+// Any exponent is accepted and normalized to 2 fractional digits; NaN, Infinity
+// and values with more than 2 significant fractional digits are rejected.
+func FromDecimal128(high, low uint64) (Amount, error) {
+	neg := high&d128SignBit != 0
+	if (high>>61)&0x3 == 0x3 {
+		switch (high >> 58) & 0x1f {
+		case 0x1e:
+			return 0, fmt.Errorf("money: decimal128 Infinity")
+		case 0x1f:
+			return 0, fmt.Errorf("money: decimal128 NaN")
+		default:
+			// Non-canonical coefficient (>= 2^113 > 10^34): the value is zero.
+			return 0, nil
+		}
+	}
+
+	exp := int((high>>49)&0x3fff) - d128Bias
+	coeff := new(big.Int).Lsh(new(big.Int).SetUint64(high&d128CoeffHiMask), 64)
+	coeff.Or(coeff, new(big.Int).SetUint64(low))
+	if coeff.Cmp(d128MaxCoeff) > 0 {
+		// Non-canonical coefficient: the value is zero.
+		return 0, nil
+	}
+	if coeff.Sign() == 0 {
+		return 0, nil
+	}
+
+	switch shift := exp + 2; {
+	case shift > 0:
+		if shift > 19 {
+			return 0, fmt.Errorf("money: decimal128 overflow: %sE%d", coeff, exp)
+		}
+		coeff.Mul(coeff, pow10(shift))
+	case shift < 0:
+		if -shift > 34 {
+			return 0, fmt.Errorf("money: decimal128 %sE%d has more than 2 fractional digits", coeff, exp)
+		}
+		var r big.Int
+		coeff.QuoRem(coeff, pow10(-shift), &r)
+		if r.Sign() != 0 {
+			return 0, fmt.Errorf("money: decimal128 %sE%d has more than 2 fractional digits", coeff, exp)
+		}
+	}
+
+	if neg {
+		coeff.Neg(coeff)
+	}
+	if !coeff.IsInt64() {
+		return 0, fmt.Errorf("money: decimal128 overflow: %s minor units", coeff)
+	}
+	return Amount(coeff.Int64()), nil
+}
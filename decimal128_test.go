@@ -0,0 +1,77 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmount_Decimal128(t *testing.T) {
+	cases := []struct {
+		minor     int64
+		high, low uint64
+	}{
+		{0, 0x303c000000000000, 0},       // 0.00
+		{1234, 0x303c000000000000, 1234}, // 12.34
+		{-1234, 0xb03c000000000000, 1234},
+		{math.MinInt64, 0xb03c000000000000, 1 << 63},
+	}
+	for _, tc := range cases {
+		h, l := money.NewMinor(tc.minor).Decimal128()
+		if h != tc.high || l != tc.low {
+			t.Fatalf("minor=%d got=(%#x,%#x) want=(%#x,%#x)", tc.minor, h, l, tc.high, tc.low)
+		}
+		back, err := money.FromDecimal128(h, l)
+		if err != nil || back.Minor() != tc.minor {
+			t.Fatalf("minor=%d round-trip got=%d err=%v", tc.minor, back.Minor(), err)
+		}
+	}
+}
+
+func TestFromDecimal128_Normalize(t *testing.T) {
+	cases := []struct {
+		name      string
+		high, low uint64
+		want      int64
+	}{
+		{"1", 0x3040000000000000, 1, 100},
+		{"1E+3", 0x3046000000000000, 1, 100000},
+		{"0.010", 0x303a000000000000, 10, 1},
+		{"12.3400", 0x3038000000000000, 123400, 1234},
+		{"-0", 0xb040000000000000, 0, 0},
+		{"0E+100", 0x3040000000000000 + 100<<49, 0, 0},
+		{"non-canonical", 0x6c10000000000000, 0, 0},
+	}
+	for _, tc := range cases {
+		a, err := money.FromDecimal128(tc.high, tc.low)
+		if err != nil {
+			t.Fatalf("%s err: %v", tc.name, err)
+		}
+		if a.Minor() != tc.want {
+			t.Fatalf("%s got=%d want=%d", tc.name, a.Minor(), tc.want)
+		}
+	}
+}
+
+func TestFromDecimal128_Errors(t *testing.T) {
+	cases := []struct {
+		name      string
+		high, low uint64
+	}{
+		{"Infinity", 0x7800000000000000, 0},
+		{"-Infinity", 0xf800000000000000, 0},
+		{"NaN", 0x7c00000000000000, 0},
+		{"sNaN", 0x7e00000000000000, 0},
+		{"0.001", 0x303a000000000000, 1},
+		{"1E-40", 0x3040000000000000 - 40<<49, 1},
+		{"1E+17", 0x3040000000000000 + 17<<49, 1},
+		{"1E+30", 0x3040000000000000 + 30<<49, 1},
+		{"2^64 E-2", 0x303c000000000001, 0},
+	}
+	for _, tc := range cases {
+		if _, err := money.FromDecimal128(tc.high, tc.low); err == nil {
+			t.Fatalf("%s expected error", tc.name)
+		}
+	}
+}
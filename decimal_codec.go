@@ -0,0 +1,141 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Binary decimal encodings for Avro and Parquet decimal logical types.
+// The spec's Scale is the scale of the logical type (e.g. decimal(12,2) => Decimal(12, 2)).
+
+// EncodeBytes returns the unscaled value as minimal big-endian two's complement
+// (Avro "bytes" decimal, Parquet BYTE_ARRAY decimal).
+func (s DecimalSpec) EncodeBytes(a Amount) ([]byte, error) {
+	u, err := s.unscaled(a)
+	if err != nil {
+		return nil, err
+	}
+	return twosComplement(u), nil
+}
+
+// EncodeFixed returns the unscaled value sign-extended to size bytes
+// (Avro "fixed" decimal, Parquet FIXED_LEN_BYTE_ARRAY decimal).
+func (s DecimalSpec) EncodeFixed(a Amount, size int) ([]byte, error) {
+	b, err := s.EncodeBytes(a)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > size {
+		return nil, fmt.Errorf("money: %s needs %d bytes, fixed size is %d", a.StringFixed2(), len(b), size)
+	}
+	out := make([]byte, size)
+	if b[0]&0x80 != 0 {
+		for i := range out {
+			out[i] = 0xff
+		}
+	}
+	copy(out[size-len(b):], b)
+	return out, nil
+}
+
+// DecodeBytes decodes big-endian two's complement bytes (minimal or fixed size).
+// It rejects values exceeding the precision or carrying sub-kuruş digits.
+func (s DecimalSpec) DecodeBytes(b []byte) (Amount, error) {
+	if len(b) == 0 {
+		return 0, fmt.Errorf("money: empty decimal bytes")
+	}
+	u := new(big.Int).SetBytes(b)
+	if b[0]&0x80 != 0 {
+		u.Sub(u, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+	return s.fromUnscaled(u)
+}
+
+// EncodeInt64 returns the unscaled value for Parquet INT64 decimals (precision <= 18).
+func (s DecimalSpec) EncodeInt64(a Amount) (int64, error) {
+	if s.Precision > 18 {
+		return 0, fmt.Errorf("money: DECIMAL(%d,%d) does not fit INT64", s.Precision, s.Scale)
+	}
+	u, err := s.unscaled(a)
+	if err != nil {
+		return 0, err
+	}
+	return u.Int64(), nil
+}
+
+// EncodeInt32 returns the unscaled value for Parquet INT32 decimals (precision <= 9).
+func (s DecimalSpec) EncodeInt32(a Amount) (int32, error) {
+	if s.Precision > 9 {
+		return 0, fmt.Errorf("money: DECIMAL(%d,%d) does not fit INT32", s.Precision, s.Scale)
+	}
+	u, err := s.unscaled(a)
+	if err != nil {
+		return 0, err
+	}
+	return int32(u.Int64()), nil
+}
+
+func (s DecimalSpec) DecodeInt64(v int64) (Amount, error) {
+	return s.fromUnscaled(big.NewInt(v))
+}
+
+func (s DecimalSpec) DecodeInt32(v int32) (Amount, error) {
+	return s.fromUnscaled(big.NewInt(int64(v)))
+}
+
+// unscaled returns a * 10^(Scale-2) after checking the amount fits the spec.
+func (s DecimalSpec) unscaled(a Amount) (*big.Int, error) {
+	if err := s.Fits(a); err != nil {
+		return nil, err
+	}
+	u := big.NewInt(int64(a))
+	if s.Scale >= 2 {
+		return u.Mul(u, pow10(s.Scale-2)), nil
+	}
+	return u.Quo(u, pow10(2-s.Scale)), nil
+}
+
+func (s DecimalSpec) fromUnscaled(u *big.Int) (Amount, error) {
+	if err := s.Validate(); err != nil {
+		return 0, err
+	}
+	if digits := len(new(big.Int).Abs(u).String()); u.Sign() != 0 && digits > s.Precision {
+		return 0, fmt.Errorf("money: unscaled %s exceeds DECIMAL(%d,%d) precision", u, s.Precision, s.Scale)
+	}
+	m := new(big.Int)
+	if s.Scale >= 2 {
+		var r big.Int
+		m.QuoRem(u, pow10(s.Scale-2), &r)
+		if r.Sign() != 0 {
+			return 0, fmt.Errorf("money: unscaled %s at scale %d loses sub-kuruş precision", u, s.Scale)
+		}
+	} else {
+		m.Mul(u, pow10(2-s.Scale))
+	}
+	if !m.IsInt64() {
+		return 0, fmt.Errorf("money: overflow: unscaled %s at scale %d", u, s.Scale)
+	}
+	return Amount(m.Int64()), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// twosComplement returns the minimal big-endian two's complement form of x.
+func twosComplement(x *big.Int) []byte {
+	if x.Sign() >= 0 {
+		b := x.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// n bytes hold [-2^(8n-1), 2^(8n-1)); -x-1 must fit in 8n-1 bits.
+	m := new(big.Int).Neg(x)
+	m.Sub(m, big.NewInt(1))
+	n := m.BitLen()/8 + 1
+	v := new(big.Int).Lsh(big.NewInt(1), uint(8*n))
+	v.Add(v, x)
+	return v.FillBytes(make([]byte, n))
+}
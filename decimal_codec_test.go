@@ -0,0 +1,111 @@
+package money_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestDecimalSpec_EncodeBytes(t *testing.T) {
+	spec := money.Decimal(12, 2)
+	cases := []struct {
+		minor int64
+		want  []byte
+	}{
+		{0, []byte{0x00}},
+		{1234, []byte{0x04, 0xd2}},
+		{-1234, []byte{0xfb, 0x2e}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x00, 0x80}},
+		{-128, []byte{0x80}},
+		{-129, []byte{0xff, 0x7f}},
+	}
+	for _, tc := range cases {
+		got, err := spec.EncodeBytes(money.NewMinor(tc.minor))
+		if err != nil {
+			t.Fatalf("minor=%d err: %v", tc.minor, err)
+		}
+		if !bytes.Equal(got, tc.want) {
+			t.Fatalf("minor=%d got=%x want=%x", tc.minor, got, tc.want)
+		}
+		back, err := spec.DecodeBytes(got)
+		if err != nil || back.Minor() != tc.minor {
+			t.Fatalf("minor=%d decode got=%d err=%v", tc.minor, back.Minor(), err)
+		}
+	}
+}
+
+func TestDecimalSpec_Rescale(t *testing.T) {
+	// decimal(18,4): 12.34 => unscaled 123400
+	spec := money.Decimal(18, 4)
+	v, err := spec.EncodeInt64(money.NewMinor(1234))
+	if err != nil || v != 123400 {
+		t.Fatalf("got=%d err=%v want=123400", v, err)
+	}
+	if _, err := spec.DecodeInt64(123401); err == nil {
+		t.Fatalf("expected sub-kuruş precision error")
+	}
+
+	// decimal(9,0): whole units only
+	spec = money.Decimal(9, 0)
+	v32, err := spec.EncodeInt32(money.NewMinor(4200))
+	if err != nil || v32 != 42 {
+		t.Fatalf("got=%d err=%v want=42", v32, err)
+	}
+	if _, err := spec.EncodeInt32(money.NewMinor(4250)); err == nil {
+		t.Fatalf("expected error for fractional amount at scale 0")
+	}
+	a, err := spec.DecodeInt32(-42)
+	if err != nil || a.Minor() != -4200 {
+		t.Fatalf("got=%d err=%v want=-4200", a.Minor(), err)
+	}
+}
+
+func TestDecimalSpec_EncodeFixed(t *testing.T) {
+	spec := money.Decimal(38, 10) // Parquet FIXED_LEN_BYTE_ARRAY(16)
+	for _, minor := range []int64{0, 1, -1, math.MaxInt64, math.MinInt64} {
+		b, err := spec.EncodeFixed(money.NewMinor(minor), 16)
+		if err != nil {
+			t.Fatalf("minor=%d err: %v", minor, err)
+		}
+		if len(b) != 16 {
+			t.Fatalf("minor=%d len=%d want=16", minor, len(b))
+		}
+		back, err := spec.DecodeBytes(b)
+		if err != nil || back.Minor() != minor {
+			t.Fatalf("minor=%d decode got=%d err=%v", minor, back.Minor(), err)
+		}
+	}
+
+	if _, err := money.Decimal(12, 2).EncodeFixed(money.NewMinor(100000), 2); err == nil {
+		t.Fatalf("expected error when value does not fit fixed size")
+	}
+}
+
+func TestDecimalSpec_PrecisionChecks(t *testing.T) {
+	spec := money.Decimal(12, 2)
+
+	// 10,000,000,000.00 has 13 digits
+	if _, err := spec.EncodeBytes(money.NewMinor(1_000_000_000_000)); err == nil {
+		t.Fatalf("expected precision error on encode")
+	}
+	if _, err := spec.DecodeInt64(1_000_000_000_000); err == nil {
+		t.Fatalf("expected precision error on decode")
+	}
+	if _, err := money.Decimal(19, 2).EncodeInt64(money.NewMinor(1)); err == nil {
+		t.Fatalf("expected error: precision 19 does not fit INT64")
+	}
+	if _, err := money.Decimal(10, 2).EncodeInt32(money.NewMinor(1)); err == nil {
+		t.Fatalf("expected error: precision 10 does not fit INT32")
+	}
+	if _, err := spec.DecodeBytes(nil); err == nil {
+		t.Fatalf("expected error for empty bytes")
+	}
+	// 2^64 at scale 2 overflows Amount
+	big := []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0}
+	if _, err := money.Decimal(38, 2).DecodeBytes(big); err == nil {
+		t.Fatalf("expected overflow error")
+	}
+}
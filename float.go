@@ -0,0 +1,98 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// FloatScan controls how float64/float32 driver values (FLOAT columns, SUM() results)
+// are converted by DBAmount.Scan.
+//
+// The zero value is strict (see StrictFloats); rounding requires an explicit
+// mode via RoundFloats.
+type FloatScan struct {
+	round bool
+	mode  RoundingMode
+}
+
+// StrictFloats rejects floats that are not exactly representable at 2 decimals
+// (i.e. whose shortest decimal form has more than 2 fractional digits).
+func StrictFloats() FloatScan { return FloatScan{} }
+
+// RoundFloats rounds the shortest decimal form of a float to 2 decimals with mode.
+func RoundFloats(mode RoundingMode) FloatScan { return FloatScan{round: true, mode: mode} }
+
+var floatScan atomic.Pointer[FloatScan]
+
+// SetFloatScan sets the package default used by DBAmount.Scan (safe for concurrent use).
+func SetFloatScan(p FloatScan) { floatScan.Store(&p) }
+
+func defaultFloatScan() FloatScan {
+	if p := floatScan.Load(); p != nil {
+		return *p
+	}
+	return StrictFloats()
+}
+
+// Convert converts a float with the given bit size (32 or 64) to an Amount.
+func (p FloatScan) Convert(f float64, bitSize int) (Amount, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("money: non-finite float %v", f)
+	}
+
+	s := strconv.FormatFloat(f, 'f', -1, bitSize)
+	if p.round {
+		return roundDecimal(s, p.mode)
+	}
+	if _, frac, _ := strings.Cut(s, "."); len(frac) > 2 {
+		return 0, fmt.Errorf("money: float %s is not exact at 2 decimals", s)
+	}
+	return ParseString(s)
+}
+
+// roundDecimal parses a plain decimal string with any number of fractional digits,
+// rounding to 2 decimals with mode.
+func roundDecimal(s string, mode RoundingMode) (Amount, error) {
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if len(frac) <= 2 {
+		return ParseString(s)
+	}
+	a, err := ParseString(whole + "." + frac[:2])
+	if err != nil {
+		return 0, err
+	}
+	rest := frac[2:]
+	if strings.Trim(rest, "0123456789") != "" {
+		return 0, fmt.Errorf("money: invalid fractional part: %q", s)
+	}
+	if strings.Trim(rest, "0") == "" {
+		return a, nil
+	}
+
+	neg := strings.HasPrefix(whole, "-")
+	var up bool // away from zero
+	switch mode {
+	case RoundFloor:
+		up = neg
+	case RoundCeil:
+		up = !neg
+	case RoundHalfUp:
+		up = rest[0] >= '5'
+	}
+	if !up {
+		return a, nil
+	}
+	if neg {
+		if a == math.MinInt64 {
+			return 0, fmt.Errorf("money: overflow: %q", s)
+		}
+		return a - 1, nil
+	}
+	if a == math.MaxInt64 {
+		return 0, fmt.Errorf("money: overflow: %q", s)
+	}
+	return a + 1, nil
+}
@@ -0,0 +1,105 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestFloatScan_Strict(t *testing.T) {
+	p := money.StrictFloats()
+
+	ok := []struct {
+		in   float64
+		want int64
+	}{
+		{12.34, 1234},
+		{419.29, 41929},
+	}
+	for _, tc := range ok {
+		a, err := p.Convert(tc.in, 64)
+		if err != nil {
+			t.Fatalf("in=%v err: %v", tc.in, err)
+		}
+		if a.Minor() != tc.want {
+			t.Fatalf("in=%v got=%d want=%d", tc.in, a.Minor(), tc.want)
+		}
+	}
+
+	// x+y == 0.30000000000000004: not exact
+	x, y := 0.1, 0.2
+	for _, in := range []float64{0.125, 12.345, x + y, math.NaN(), math.Inf(1), 1e20} {
+		if _, err := p.Convert(in, 64); err == nil {
+			t.Fatalf("in=%v expected error", in)
+		}
+	}
+
+	// float32 uses its own shortest form
+	a, err := p.Convert(float64(float32(12.34)), 32)
+	if err != nil || a.Minor() != 1234 {
+		t.Fatalf("float32 got=%d err=%v want=1234", a.Minor(), err)
+	}
+}
+
+func TestFloatScan_Round(t *testing.T) {
+	cases := []struct {
+		mode money.RoundingMode
+		in   float64
+		want int64
+	}{
+		{money.RoundHalfUp, 0.125, 13},
+		{money.RoundHalfUp, 12.345, 1235},
+		{money.RoundHalfUp, -12.345, -1235},
+		{money.RoundHalfUp, 12.344, 1234},
+		{money.RoundFloor, 12.349, 1234},
+		{money.RoundFloor, -12.341, -1235},
+		{money.RoundCeil, 12.341, 1235},
+		{money.RoundCeil, -12.349, -1234},
+		{money.RoundHalfUp, 12.34, 1234},
+	}
+	for _, tc := range cases {
+		a, err := money.RoundFloats(tc.mode).Convert(tc.in, 64)
+		if err != nil {
+			t.Fatalf("mode=%d in=%v err: %v", tc.mode, tc.in, err)
+		}
+		if a.Minor() != tc.want {
+			t.Fatalf("mode=%d in=%v got=%d want=%d", tc.mode, tc.in, a.Minor(), tc.want)
+		}
+	}
+}
+
+func TestDBAmount_Scan_Float_DefaultPolicy(t *testing.T) {
+	t.Cleanup(func() { money.SetFloatScan(money.StrictFloats()) })
+
+	// Default (zero value) is strict: no silent rounding
+	var m money.DBAmount
+	for _, in := range []any{0.125, 12.345} {
+		if err := m.Scan(in); err == nil {
+			t.Fatalf("default: expected error for %v", in)
+		}
+	}
+	var a money.Amount
+	if err := a.Scan(0.125); err == nil {
+		t.Fatalf("default: expected error for Amount.Scan(0.125)")
+	}
+	if (money.FloatScan{}) != money.StrictFloats() {
+		t.Fatalf("zero FloatScan is not strict")
+	}
+
+	money.SetFloatScan(money.StrictFloats())
+	if err := m.Scan(12.345); err == nil {
+		t.Fatalf("strict: expected error for 12.345")
+	}
+	if err := m.Scan(float32(0.125)); err == nil {
+		t.Fatalf("strict: expected error for float32 0.125")
+	}
+	if err := m.Scan(12.34); err != nil || m.A.Minor() != 1234 {
+		t.Fatalf("strict: got=%d err=%v want=1234", m.A.Minor(), err)
+	}
+
+	money.SetFloatScan(money.RoundFloats(money.RoundHalfUp))
+	if err := m.Scan(0.125); err != nil || m.A.Minor() != 13 {
+		t.Fatalf("round: got=%d err=%v want=13", m.A.Minor(), err)
+	}
+}
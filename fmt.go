@@ -0,0 +1,76 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// String implements fmt.Stringer; same as StringFixed2.
+func (a Amount) String() string { return a.StringFixed2() }
+
+// Format implements fmt.Formatter.
+//
+//	%s, %v   "12.34" (StringFixed2)
+//	%+s, %+v "+12.34" (always signed)
+//	%q       "\"12.34\""
+//	%d       1234 (minor units, all integer flags supported)
+//	%#v      money.Amount(1234)
+//
+// Width and the '-' and '0' flags are supported for %s and %v.
+func (a Amount) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(a))
+	case 'v', 's', 'q':
+		if verb == 'v' && f.Flag('#') {
+			fmt.Fprintf(f, "money.Amount(%d)", int64(a))
+			return
+		}
+		s := a.StringFixed2()
+		sign := ""
+		if a < 0 {
+			sign, s = "-", s[1:]
+		} else if f.Flag('+') {
+			sign = "+"
+		}
+		if verb == 'q' {
+			s = strconv.Quote(sign + s)
+			sign = ""
+		}
+		pad(f, sign, s)
+	default:
+		fmt.Fprintf(f, "%%!%c(money.Amount=%s)", verb, a.StringFixed2())
+	}
+}
+
+// pad writes sign+s honoring width and the '-' / '0' flags; zero padding goes after the sign.
+func pad(f fmt.State, sign, s string) {
+	w, ok := f.Width()
+	n := w - len(sign) - len(s)
+	if !ok || n <= 0 {
+		f.Write([]byte(sign + s))
+		return
+	}
+	b := make([]byte, 0, w)
+	switch {
+	case f.Flag('-'):
+		b = append(b, sign...)
+		b = append(b, s...)
+		for ; n > 0; n-- {
+			b = append(b, ' ')
+		}
+	case f.Flag('0'):
+		b = append(b, sign...)
+		for ; n > 0; n-- {
+			b = append(b, '0')
+		}
+		b = append(b, s...)
+	default:
+		for ; n > 0; n-- {
+			b = append(b, ' ')
+		}
+		b = append(b, sign...)
+		b = append(b, s...)
+	}
+	f.Write(b)
+}
@@ -0,0 +1,75 @@
+package money_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmount_String(t *testing.T) {
+	var s fmt.Stringer = money.NewMinor(-1234)
+	if got := s.String(); got != "-12.34" {
+		t.Fatalf("got=%q want=%q", got, "-12.34")
+	}
+}
+
+func TestAmount_Format_Verbs(t *testing.T) {
+	pos := money.NewMinor(1234)
+	neg := money.NewMinor(-1234)
+
+	cases := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", pos, "12.34"},
+		{"%s", pos, "12.34"},
+		{"%v", neg, "-12.34"},
+		{"%+v", pos, "+12.34"},
+		{"%+v", neg, "-12.34"},
+		{"%+s", money.NewMinor(0), "+0.00"},
+		{"%q", pos, `"12.34"`},
+		{"%d", pos, "1234"},
+		{"%+d", pos, "+1234"},
+		{"%06d", neg, "-01234"},
+		{"%#v", pos, "money.Amount(1234)"},
+		{"%8v", pos, "   12.34"},
+		{"%-8v|", pos, "12.34   |"},
+		{"%08v", neg, "-0012.34"},
+		{"%+8s", pos, "  +12.34"},
+		{"%x", pos, "%!x(money.Amount=12.34)"},
+		{"%v", []money.Amount{1, 250}, "[0.01 2.50]"},
+		{"%v", struct{ P money.Amount }{pos}, "{12.34}"},
+	}
+	for _, tc := range cases {
+		if got := fmt.Sprintf(tc.format, tc.arg); got != tc.want {
+			t.Fatalf("format=%q got=%q want=%q", tc.format, got, tc.want)
+		}
+	}
+}
+
+func TestAmount_String_MinInt64(t *testing.T) {
+	a := money.NewMinor(math.MinInt64)
+	want := "-92233720368547758.08"
+	if got := a.String(); got != want {
+		t.Fatalf("String got=%q want=%q", got, want)
+	}
+	if got := fmt.Sprintf("%+v|%q", a, a); got != want+`|"`+want+`"` {
+		t.Fatalf("got=%q", got)
+	}
+	// same magnitude as FormatLocale and Words
+	if got := a.FormatLocale(money.FormatOptions{}); got != want {
+		t.Fatalf("FormatLocale got=%q want=%q", got, want)
+	}
+}
+
+func TestAmount_Format_ConsistentWithStringFixed2(t *testing.T) {
+	for _, minor := range []int64{0, 1, -1, 99, -100, 123456789, math.MinInt64} {
+		a := money.NewMinor(minor)
+		if got := fmt.Sprint(a); got != a.StringFixed2() {
+			t.Fatalf("minor=%d Sprint=%q StringFixed2=%q", minor, got, a.StringFixed2())
+		}
+	}
+}
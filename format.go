@@ -0,0 +1,111 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SymbolPosition places the currency symbol relative to the number.
+type SymbolPosition int
+
+const (
+	SymbolNone SymbolPosition = iota
+	SymbolBefore
+	SymbolAfter
+)
+
+// NegativeStyle selects how negative amounts are rendered.
+type NegativeStyle int
+
+const (
+	NegativeMinus  NegativeStyle = iota // -₺5,00
+	NegativeParens                      // (₺5,00), accounting style
+)
+
+// FormatOptions configures Amount.FormatLocale.
+type FormatOptions struct {
+	Decimal     rune   // decimal separator
+	Group       rune   // grouping separator; 0 disables grouping
+	Symbol      string // currency symbol or code, e.g. "₺" or "TL"
+	Position    SymbolPosition
+	SymbolSpace bool // put a space between symbol and number
+	Negative    NegativeStyle
+}
+
+// Locale presets.
+var (
+	FormatTR = FormatOptions{Decimal: ',', Group: '.', Symbol: "₺", Position: SymbolBefore}                   // ₺1.234,56
+	FormatEN = FormatOptions{Decimal: '.', Group: ',', Symbol: "₺", Position: SymbolBefore}                   // ₺1,234.56
+	FormatDE = FormatOptions{Decimal: ',', Group: '.', Symbol: "₺", Position: SymbolAfter, SymbolSpace: true} // 1.234,56 ₺
+)
+
+// FormatPreset returns the preset for a locale tag ("tr-TR", "en-US", "de-DE").
+func FormatPreset(tag string) (FormatOptions, bool) {
+	switch strings.ToLower(tag) {
+	case "tr-tr", "tr":
+		return FormatTR, true
+	case "en-us", "en":
+		return FormatEN, true
+	case "de-de", "de":
+		return FormatDE, true
+	default:
+		return FormatOptions{}, false
+	}
+}
+
+// FormatLocale renders the amount with grouping, symbol and sign style (integer math only).
+// Examples:
+// FormatTR: 123456 -> "₺1.234,56", -500 -> "-₺5,00"
+// FormatOptions{Decimal: ',', Group: '.', Symbol: "TL", Position: SymbolAfter, SymbolSpace: true}: "1.234,56 TL"
+// FormatOptions{Decimal: ',', Negative: NegativeParens}: -500 -> "(5,00)"
+func (a Amount) FormatLocale(opts FormatOptions) string {
+	dec := opts.Decimal
+	if dec == 0 {
+		dec = '.'
+	}
+
+	mag := uint64(a)
+	if a < 0 {
+		mag = -mag
+	}
+	whole := strconv.FormatUint(mag/100, 10)
+	frac := mag % 100
+
+	var b strings.Builder
+	b.Grow(len(whole) + len(whole)/3 + len(opts.Symbol) + 8)
+
+	if a < 0 {
+		if opts.Negative == NegativeParens {
+			b.WriteByte('(')
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	if opts.Position == SymbolBefore && opts.Symbol != "" {
+		b.WriteString(opts.Symbol)
+		if opts.SymbolSpace {
+			b.WriteByte(' ')
+		}
+	}
+
+	for i := 0; i < len(whole); i++ {
+		if opts.Group != 0 && i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteRune(opts.Group)
+		}
+		b.WriteByte(whole[i])
+	}
+	b.WriteRune(dec)
+	b.WriteByte(byte('0' + frac/10))
+	b.WriteByte(byte('0' + frac%10))
+
+	if opts.Position == SymbolAfter && opts.Symbol != "" {
+		if opts.SymbolSpace {
+			b.WriteByte(' ')
+		}
+		b.WriteString(opts.Symbol)
+	}
+	if a < 0 && opts.Negative == NegativeParens {
+		b.WriteByte(')')
+	}
+	return b.String()
+}
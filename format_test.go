@@ -0,0 +1,84 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmount_Format_Presets(t *testing.T) {
+	cases := []struct {
+		opts  money.FormatOptions
+		minor int64
+		want  string
+	}{
+		{money.FormatTR, 123456, "₺1.234,56"},
+		{money.FormatTR, -500, "-₺5,00"},
+		{money.FormatTR, 0, "₺0,00"},
+		{money.FormatTR, 100000000, "₺1.000.000,00"},
+		{money.FormatEN, 123456, "₺1,234.56"},
+		{money.FormatEN, 99, "₺0.99"},
+		{money.FormatDE, 123456, "1.234,56 ₺"},
+		{money.FormatDE, -123456, "-1.234,56 ₺"},
+	}
+	for _, tc := range cases {
+		if got := money.NewMinor(tc.minor).FormatLocale(tc.opts); got != tc.want {
+			t.Fatalf("minor=%d got=%q want=%q", tc.minor, got, tc.want)
+		}
+	}
+}
+
+func TestAmount_Format_Custom(t *testing.T) {
+	tl := money.FormatOptions{Decimal: ',', Group: '.', Symbol: "TL", Position: money.SymbolAfter, SymbolSpace: true}
+	if got := money.NewMinor(123456).FormatLocale(tl); got != "1.234,56 TL" {
+		t.Fatalf("got=%q want=%q", got, "1.234,56 TL")
+	}
+
+	parens := money.FormatOptions{Decimal: ',', Group: '.', Negative: money.NegativeParens}
+	if got := money.NewMinor(-500).FormatLocale(parens); got != "(5,00)" {
+		t.Fatalf("got=%q want=%q", got, "(5,00)")
+	}
+	if got := money.NewMinor(500).FormatLocale(parens); got != "5,00" {
+		t.Fatalf("got=%q want=%q", got, "5,00")
+	}
+
+	parens.Symbol, parens.Position = "₺", money.SymbolBefore
+	if got := money.NewMinor(-123456).FormatLocale(parens); got != "(₺1.234,56)" {
+		t.Fatalf("got=%q want=%q", got, "(₺1.234,56)")
+	}
+
+	// zero value: plain "1234.56", same as StringFixed2
+	if got := money.NewMinor(-123456).FormatLocale(money.FormatOptions{}); got != "-1234.56" {
+		t.Fatalf("got=%q want=%q", got, "-1234.56")
+	}
+}
+
+func TestAmount_Format_MinInt64(t *testing.T) {
+	got := money.NewMinor(math.MinInt64).FormatLocale(money.FormatOptions{Decimal: '.', Group: ','})
+	if got != "-92,233,720,368,547,758.08" {
+		t.Fatalf("got=%q", got)
+	}
+}
+
+func TestAmount_Format_RoundTripsParseLocale(t *testing.T) {
+	for _, minor := range []int64{0, 1, -1, 99, 100, 123456, -987654321} {
+		s := money.NewMinor(minor).FormatLocale(money.FormatTR)
+		a, err := money.ParseLocale(s, money.ParseTR)
+		if err != nil {
+			t.Fatalf("minor=%d s=%q err: %v", minor, s, err)
+		}
+		if a.Minor() != minor {
+			t.Fatalf("minor=%d s=%q got=%d", minor, s, a.Minor())
+		}
+	}
+}
+
+func TestFormatPreset(t *testing.T) {
+	if o, ok := money.FormatPreset("de-DE"); !ok || o != money.FormatDE {
+		t.Fatalf("de-DE preset mismatch")
+	}
+	if _, ok := money.FormatPreset("xx-XX"); ok {
+		t.Fatalf("expected unknown preset")
+	}
+}
@@ -0,0 +1,91 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Alternative JSON wire formats. Amount itself always uses the string form "12.34".
+// Convert with a type conversion: money.JSONNumber(a), money.Amount(n).
+
+// JSONNumber marshals as a JSON number: 12.34.
+// Decoding goes through json.Number and ParseString (never float64); strings are rejected.
+type JSONNumber Amount
+
+// JSONMinor marshals as integer minor units: 1234.
+type JSONMinor Amount
+
+// JSONObject marshals as {"amount":"12.34","currency":"TRY"}.
+type JSONObject struct {
+	Amount   Amount `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func (n JSONNumber) MarshalJSON() ([]byte, error) {
+	return []byte(Amount(n).StringFixed2()), nil
+}
+
+func (n *JSONNumber) UnmarshalJSON(b []byte) error {
+	num, err := jsonNumber(b)
+	if err != nil {
+		return err
+	}
+	a, err := ParseString(num.String())
+	if err != nil {
+		return err
+	}
+	*n = JSONNumber(a)
+	return nil
+}
+
+func (m JSONMinor) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(m), 10), nil
+}
+
+func (m *JSONMinor) UnmarshalJSON(b []byte) error {
+	num, err := jsonNumber(b)
+	if err != nil {
+		return err
+	}
+	v, err := strconv.ParseInt(num.String(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("money: invalid minor units %s: %w", num, err)
+	}
+	*m = JSONMinor(v)
+	return nil
+}
+
+func (o *JSONObject) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Amount   *Amount `json:"amount"`
+		Currency string  `json:"currency"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&raw); err != nil {
+		return fmt.Errorf("money: invalid amount object: %w", err)
+	}
+	if raw.Amount == nil {
+		return fmt.Errorf("money: amount object missing \"amount\"")
+	}
+	if raw.Currency == "" {
+		return fmt.Errorf("money: amount object missing \"currency\"")
+	}
+	o.Amount, o.Currency = *raw.Amount, raw.Currency
+	return nil
+}
+
+// jsonNumber decodes a bare JSON number token; quoted numbers and null are rejected.
+func jsonNumber(b []byte) (json.Number, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || (b[0] != '-' && (b[0] < '0' || b[0] > '9')) {
+		return "", fmt.Errorf("money: expected JSON number, got %s", b)
+	}
+	var num json.Number
+	if err := json.Unmarshal(b, &num); err != nil {
+		return "", err
+	}
+	return num, nil
+}
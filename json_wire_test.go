@@ -0,0 +1,91 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestJSONNumber(t *testing.T) {
+	type partnerDTO struct {
+		Price money.JSONNumber `json:"price"`
+	}
+
+	b, err := json.Marshal(partnerDTO{Price: money.JSONNumber(money.NewMinor(-1234))})
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != `{"price":-12.34}` {
+		t.Fatalf("got=%s", b)
+	}
+
+	var d partnerDTO
+	if err := json.Unmarshal([]byte(`{"price":12.3}`), &d); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if money.Amount(d.Price).Minor() != 1230 {
+		t.Fatalf("got=%d want=1230", money.Amount(d.Price).Minor())
+	}
+
+	for _, in := range []string{`{"price":"12.34"}`, `{"price":12.345}`, `{"price":1e3}`, `{"price":null}`, `{"price":true}`} {
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Fatalf("in=%s expected error", in)
+		}
+	}
+}
+
+func TestJSONMinor(t *testing.T) {
+	b, err := json.Marshal(money.JSONMinor(money.NewMinor(1234)))
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != `1234` {
+		t.Fatalf("got=%s want=1234", b)
+	}
+
+	var m money.JSONMinor
+	if err := json.Unmarshal([]byte(`-50`), &m); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if m != -50 {
+		t.Fatalf("got=%d want=-50", m)
+	}
+
+	for _, in := range []string{`12.34`, `"1234"`, `1e3`, `9223372036854775808`, `null`} {
+		if err := json.Unmarshal([]byte(in), &m); err == nil {
+			t.Fatalf("in=%s expected error", in)
+		}
+	}
+}
+
+func TestJSONObject(t *testing.T) {
+	o := money.JSONObject{Amount: money.NewMinor(1234), Currency: "TRY"}
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != `{"amount":"12.34","currency":"TRY"}` {
+		t.Fatalf("got=%s", b)
+	}
+
+	var back money.JSONObject
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if back != o {
+		t.Fatalf("got=%+v want=%+v", back, o)
+	}
+
+	for _, in := range []string{
+		`{"currency":"TRY"}`,
+		`{"amount":"12.34"}`,
+		`{"amount":12.34,"currency":"TRY"}`,
+		`{"amount":"12.34","currency":"TRY","extra":1}`,
+		`"12.34"`,
+	} {
+		if err := json.Unmarshal([]byte(in), &back); err == nil {
+			t.Fatalf("in=%s expected error", in)
+		}
+	}
+}
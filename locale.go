@@ -0,0 +1,155 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseOptions configures ParseLocale.
+type ParseOptions struct {
+	Decimal rune     // decimal separator, e.g. ',' for tr-TR
+	Group   rune     // grouping separator; 0 disallows grouping
+	Symbols []string // currency symbols/codes stripped as prefix or suffix (case-insensitive)
+}
+
+// Common parse presets.
+var (
+	ParseTR = ParseOptions{Decimal: ',', Group: '.', Symbols: []string{"₺", "TL", "TRY"}}
+	ParseEN = ParseOptions{Decimal: '.', Group: ',', Symbols: []string{"₺", "TL", "TRY"}}
+)
+
+// ParseLocale parses a localized money string with max 2 fractional digits into minor units.
+// Examples (ParseTR):
+// "1.234,56" -> 123456
+// "₺1.234,56" -> 123456
+// "1.234,56 TL" -> 123456
+// "-₺5,00" -> -500
+// Grouping is optional, but when present every group after the first must have 3 digits.
+// Input errors are *ParseError with Offset into s.
+func ParseLocale(s string, opts ParseOptions) (Amount, error) {
+	if opts.Decimal == 0 || opts.Decimal == opts.Group {
+		return 0, fmt.Errorf("money: invalid parse options: decimal %q group %q", opts.Decimal, opts.Group)
+	}
+
+	in := s
+	fail := func(off int, kind error) (Amount, error) {
+		return 0, &ParseError{Input: in, Offset: off, Kind: kind}
+	}
+
+	// Strip space, sign and symbols, tracking lo so that s == in[lo:lo+len(s)].
+	s = strings.TrimSpace(s)
+	lo := strings.Index(in, s)
+	signPos := lo
+	sign, s := cutSign(s)
+	lo += len(sign)
+	n := len(s)
+	s, symbol := trimSymbol(s, opts.Symbols, true)
+	lo += n - len(s)
+	if symbol && sign == "" {
+		signPos = lo
+		sign, s = cutSign(s)
+		lo += len(sign)
+	}
+	s, _ = trimSymbol(s, opts.Symbols, false)
+	if s == "" {
+		return fail(lo, ErrEmpty)
+	}
+
+	// Only digits and the configured separators may remain; a stray '.' would
+	// otherwise reach ParseString as a decimal point.
+	for i, r := range s {
+		if (r < '0' || r > '9') && r != opts.Decimal && (opts.Group == 0 || r != opts.Group) {
+			return fail(lo+i, ErrNonDigit)
+		}
+	}
+
+	// "," or "-," alone must not read as zero
+	if strings.IndexFunc(s, isDigit) < 0 {
+		return fail(lo, ErrNonDigit)
+	}
+
+	dec := string(opts.Decimal)
+	whole, frac, hasFrac := strings.Cut(s, dec)
+	fracPos := lo + len(whole) + len(dec)
+	if i := strings.Index(frac, dec); i >= 0 {
+		return fail(fracPos+i, ErrMultipleDots)
+	}
+	if opts.Group != 0 {
+		if i := strings.IndexRune(frac, opts.Group); i >= 0 {
+			return fail(fracPos+i, ErrNonDigit)
+		}
+	}
+
+	// canonical is "[sign]digits[.frac]" for ParseString; offs maps each of its bytes back into in.
+	canonical := make([]byte, 0, len(s)+1)
+	offs := make([]int, 0, len(s)+1)
+	if sign != "" {
+		canonical, offs = append(canonical, sign[0]), append(offs, signPos)
+	}
+	group := string(opts.Group)
+	if opts.Group != 0 && strings.Contains(whole, group) {
+		groups := strings.Split(whole, group)
+		if n := len(groups[0]); n < 1 || n > 3 {
+			return fail(lo, ErrGrouping)
+		}
+		pos := lo + len(groups[0])
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return fail(pos, ErrGrouping)
+			}
+			pos += len(group) + len(g)
+		}
+	}
+	for i := 0; i < len(whole); i++ {
+		if c := whole[i]; c >= '0' && c <= '9' {
+			canonical, offs = append(canonical, c), append(offs, lo+i)
+		}
+	}
+	if hasFrac {
+		canonical, offs = append(canonical, '.'), append(offs, fracPos-len(dec))
+		for i := 0; i < len(frac); i++ {
+			canonical, offs = append(canonical, frac[i]), append(offs, fracPos+i)
+		}
+	}
+
+	a, err := ParseString(string(canonical))
+	if pe, ok := err.(*ParseError); ok {
+		off := lo + len(s)
+		if pe.Offset < len(offs) {
+			off = offs[pe.Offset]
+		}
+		return fail(off, pe.Kind)
+	}
+	return a, err
+}
+
+func cutSign(s string) (string, string) {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		return s[:1], s[1:]
+	}
+	return "", s
+}
+
+// trimSymbol strips the longest matching symbol prefix (or suffix) and trims surrounding space.
+func trimSymbol(s string, symbols []string, prefix bool) (string, bool) {
+	best := ""
+	for _, sym := range symbols {
+		if sym == "" || len(sym) <= len(best) || len(sym) > len(s) {
+			continue
+		}
+		part := s[len(s)-len(sym):]
+		if prefix {
+			part = s[:len(sym)]
+		}
+		if strings.EqualFold(part, sym) {
+			best = sym
+		}
+	}
+	if best == "" {
+		return s, false
+	}
+	if prefix {
+		return strings.TrimSpace(s[len(best):]), true
+	}
+	return strings.TrimSpace(s[:len(s)-len(best)]), true
+}
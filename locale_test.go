@@ -0,0 +1,161 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestParseLocale_TR(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"1.234,56", 123456},
+		{"1234,56", 123456},
+		{"₺1.234,56", 123456},
+		{"₺ 1.234,56", 123456},
+		{"1.234,56 TL", 123456},
+		{"1.234,56 tl", 123456},
+		{"1.234,56TRY", 123456},
+		{"-₺5,00", -500},
+		{"₺-5,00", -500},
+		{"-5,5 TL", -550},
+		{"12.345.678,9", 1234567890},
+		{"0,01", 1},
+		{"1.000", 100000},
+		{" 42 ", 4200},
+	}
+	for _, tc := range cases {
+		a, err := money.ParseLocale(tc.in, money.ParseTR)
+		if err != nil {
+			t.Fatalf("in=%q unexpected err: %v", tc.in, err)
+		}
+		if got := a.Minor(); got != tc.want {
+			t.Fatalf("in=%q got=%d want=%d", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestParseLocale_EN(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"1,234.56", 123456},
+		{"1234.56", 123456},
+		{"TRY 1,234.56", 123456},
+		{"+1,000,000.00", 100000000},
+	}
+	for _, tc := range cases {
+		a, err := money.ParseLocale(tc.in, money.ParseEN)
+		if err != nil {
+			t.Fatalf("in=%q unexpected err: %v", tc.in, err)
+		}
+		if got := a.Minor(); got != tc.want {
+			t.Fatalf("in=%q got=%d want=%d", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestParseLocale_SpaceGrouping(t *testing.T) {
+	opts := money.ParseOptions{Decimal: ',', Group: ' '}
+	a, err := money.ParseLocale("1 234 567,89", opts)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if a.Minor() != 123456789 {
+		t.Fatalf("got=%d want=123456789", a.Minor())
+	}
+}
+
+func TestParseLocale_Errors(t *testing.T) {
+	cases := []string{
+		"",
+		"₺",
+		"TL",
+		"1,234.56",             // wrong locale: too many decimals
+		"1.23,45",              // bad grouping
+		"1.2345,00",            // bad grouping
+		"1234.567,00",          // first group too long
+		".123,00",              // empty first group
+		"1,234,56",             // multiple decimal separators
+		"1,2.3",                // grouping in fraction
+		"12,345",               // too many decimals
+		"--5,00",               // double sign
+		"-₺-5,00",              // double sign
+		"- 5,00",               // space after sign
+		"$5,00",                // unknown symbol
+		"92233720368547758,08", // overflow
+		"abc",
+	}
+	for _, in := range cases {
+		if _, err := money.ParseLocale(in, money.ParseTR); err == nil {
+			t.Fatalf("in=%q expected error, got nil", in)
+		}
+	}
+}
+
+func TestParseLocale_InvalidOptions(t *testing.T) {
+	if _, err := money.ParseLocale("1", money.ParseOptions{Decimal: ',', Group: ','}); err == nil {
+		t.Fatalf("expected error for same decimal and group separator")
+	}
+	if _, err := money.ParseLocale("1", money.ParseOptions{}); err == nil {
+		t.Fatalf("expected error for missing decimal separator")
+	}
+}
+
+func TestParseLocale_NoGrouping(t *testing.T) {
+	opts := money.ParseOptions{Decimal: ','}
+	if _, err := money.ParseLocale("1.234,56", opts); err == nil {
+		t.Fatalf("expected error when grouping is disallowed")
+	}
+}
+
+func TestParseLocale_RejectsForeignSeparators(t *testing.T) {
+	cases := []struct {
+		in   string
+		opts money.ParseOptions
+	}{
+		{"12.34", money.ParseOptions{Decimal: ',', Group: ' '}},
+		{"12.34", money.ParseOptions{Decimal: ','}},
+		{"1 234,56", money.ParseTR},
+		{"12_34", money.ParseEN},
+	}
+	for _, tc := range cases {
+		if a, err := money.ParseLocale(tc.in, tc.opts); err == nil {
+			t.Fatalf("in=%q opts=%+v expected error, got %d", tc.in, tc.opts, a.Minor())
+		}
+	}
+}
+
+func TestParseLocale_ParseError(t *testing.T) {
+	cases := []struct {
+		in     string
+		opts   money.ParseOptions
+		kind   error
+		offset int
+	}{
+		{"1.234,567", money.ParseTR, money.ErrTooManyDecimals, 8},
+		{"₺ 1.23,45", money.ParseTR, money.ErrGrouping, 5},
+		{"1234.567,00", money.ParseTR, money.ErrGrouping, 0},
+		{"1,2,3", money.ParseTR, money.ErrMultipleDots, 3},
+		{"  12.34", money.ParseOptions{Decimal: ','}, money.ErrNonDigit, 4},
+		{"-₺92.233.720.368.547.758,09", money.ParseTR, money.ErrOverflow, 4},
+		{" TL ", money.ParseTR, money.ErrEmpty, 3},
+		{",", money.ParseTR, money.ErrNonDigit, 0},
+		{"-,", money.ParseTR, money.ErrNonDigit, 1},
+		{"₺ .,", money.ParseTR, money.ErrNonDigit, 4},
+	}
+	for _, tc := range cases {
+		_, err := money.ParseLocale(tc.in, tc.opts)
+		var pe *money.ParseError
+		if !errors.As(err, &pe) || !errors.Is(err, tc.kind) {
+			t.Fatalf("in=%q got=%v want kind %v", tc.in, err, tc.kind)
+		}
+		if pe.Input != tc.in || pe.Offset != tc.offset {
+			t.Fatalf("in=%q got input=%q offset=%d want offset=%d", tc.in, pe.Input, pe.Offset, tc.offset)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullAmount is an Amount that may be NULL (SQL) / null (JSON).
+// Unlike DBAmount, NULL is not turned into 0: "no price set" != "free".
+// For generic code, sql.Null[Amount] works as well.
+type NullAmount struct {
+	Amount Amount
+	Valid  bool // Valid is true if Amount is not NULL
+}
+
+func (n *NullAmount) Scan(value any) error {
+	if value == nil {
+		n.Amount, n.Valid = 0, false
+		return nil
+	}
+	var a Amount
+	if err := a.Scan(value); err != nil {
+		return err
+	}
+	n.Amount, n.Valid = a, true
+	return nil
+}
+
+func (n NullAmount) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Amount.Value()
+}
+
+func (n NullAmount) MarshalJSON() ([]byte, error) {
+	// JSON output: null or "12.34"
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Amount.MarshalJSON()
+}
+
+func (n *NullAmount) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		n.Amount, n.Valid = 0, false
+		return nil
+	}
+	var a Amount
+	if err := json.Unmarshal(b, &a); err != nil {
+		return err
+	}
+	n.Amount, n.Valid = a, true
+	return nil
+}
@@ -0,0 +1,122 @@
+package money_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestNullAmount_Scan(t *testing.T) {
+	var n money.NullAmount
+	if err := n.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) err: %v", err)
+	}
+	if n.Valid {
+		t.Fatalf("Scan(nil) should be invalid")
+	}
+
+	if err := n.Scan([]byte("0.00")); err != nil {
+		t.Fatalf("Scan([]byte) err: %v", err)
+	}
+	if !n.Valid || n.Amount.Minor() != 0 {
+		t.Fatalf("expected valid zero (free), got=%+v", n)
+	}
+
+	if err := n.Scan("12.34"); err != nil {
+		t.Fatalf("Scan(string) err: %v", err)
+	}
+	if !n.Valid || n.Amount.Minor() != 1234 {
+		t.Fatalf("got=%+v want valid 1234", n)
+	}
+
+	if err := n.Scan("12.345"); err == nil {
+		t.Fatalf("expected error for invalid value")
+	}
+}
+
+func TestNullAmount_Value(t *testing.T) {
+	v, err := money.NullAmount{}.Value()
+	if err != nil || v != nil {
+		t.Fatalf("invalid: got=%v err=%v want nil", v, err)
+	}
+
+	v, err = money.NullAmount{Amount: money.NewMinor(1234), Valid: true}.Value()
+	if err != nil {
+		t.Fatalf("value err: %v", err)
+	}
+	if v.(string) != "12.34" {
+		t.Fatalf("got=%v want=%q", v, "12.34")
+	}
+}
+
+func TestNullAmount_JSON(t *testing.T) {
+	type product struct {
+		SalePrice money.NullAmount `json:"salePrice"`
+	}
+
+	b, err := json.Marshal(product{})
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != `{"salePrice":null}` {
+		t.Fatalf("got=%s", b)
+	}
+
+	b, _ = json.Marshal(product{SalePrice: money.NullAmount{Amount: money.NewMinor(1234), Valid: true}})
+	if string(b) != `{"salePrice":"12.34"}` {
+		t.Fatalf("got=%s", b)
+	}
+
+	cases := []struct {
+		in    string
+		valid bool
+		minor int64
+	}{
+		{`{"salePrice":null}`, false, 0},
+		{`{}`, false, 0},
+		{`{"salePrice":"0.00"}`, true, 0},
+		{`{"salePrice":"9.99"}`, true, 999},
+	}
+	for _, tc := range cases {
+		var p product
+		if err := json.Unmarshal([]byte(tc.in), &p); err != nil {
+			t.Fatalf("in=%s err: %v", tc.in, err)
+		}
+		if p.SalePrice.Valid != tc.valid || p.SalePrice.Amount.Minor() != tc.minor {
+			t.Fatalf("in=%s got=%+v", tc.in, p.SalePrice)
+		}
+	}
+
+	var p product
+	if err := json.Unmarshal([]byte(`{"salePrice":9.99}`), &p); err == nil {
+		t.Fatalf("expected error for number")
+	}
+}
+
+func TestSQLNull_DBAmount(t *testing.T) {
+	var n sql.Null[money.DBAmount]
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Fatalf("Scan(nil) got=%+v err=%v", n, err)
+	}
+	if err := n.Scan([]byte("12.34")); err != nil {
+		t.Fatalf("Scan err: %v", err)
+	}
+	if !n.Valid || n.V.A.Minor() != 1234 {
+		t.Fatalf("got=%+v want valid 1234", n)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("value err: %v", err)
+	}
+	// Older Go versions return the Valuer itself and let database/sql convert it.
+	if vv, ok := v.(driver.Valuer); ok {
+		v, _ = vv.Value()
+	}
+	if v != "12.34" {
+		t.Fatalf("got=%v want=%q", v, "12.34")
+	}
+}
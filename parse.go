@@ -7,39 +7,75 @@ import (
 	"strings"
 )
 
+// Parse error kinds. Every input error from ParseString and ParseLocale is a *ParseError
+// wrapping one of these, so callers can use errors.Is(err, money.ErrTooManyDecimals).
+var (
+	ErrEmpty           = errors.New("money: empty string")
+	ErrTooManyDecimals = errors.New("money: too many decimal places")
+	ErrNonDigit        = errors.New("money: non-digit")
+	ErrOverflow        = errors.New("money: overflow")
+	ErrMultipleDots    = errors.New("money: multiple decimal points")
+	ErrGrouping        = errors.New("money: invalid digit grouping") // ParseLocale only
+)
+
+// ParseError describes why and where an input failed to parse.
+type ParseError struct {
+	Input  string // the input as passed to ParseString or ParseLocale
+	Offset int    // byte offset into Input; for ErrOverflow the first digit of the number
+	Kind   error  // one of ErrEmpty, ErrTooManyDecimals, ErrNonDigit, ErrOverflow, ErrMultipleDots, ErrGrouping
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v at offset %d: %q", e.Kind, e.Offset, e.Input)
+}
+
+func (e *ParseError) Unwrap() error { return e.Kind }
+
 // ParseString parses a decimal money string with max 2 fractional digits into minor units.
 // Examples:
 // "12" -> 1200
 // "12.3" -> 1230
 // "12.34" -> 1234
 // "-0.50" -> -50
+// Errors are *ParseError.
 func ParseString(s string) (Amount, error) {
+	in := s
+	fail := func(off int, kind error) (Amount, error) {
+		return 0, &ParseError{Input: in, Offset: off, Kind: kind}
+	}
+
 	s = strings.TrimSpace(s)
 	if s == "" {
-		return 0, errors.New("money: empty string")
+		return fail(0, ErrEmpty)
 	}
+	pos := strings.Index(in, s) // offset of s within in
 
 	sign := int64(1)
 	if s[0] == '-' {
 		sign = -1
-		s = s[1:]
+		s, pos = s[1:], pos+1
 	} else if s[0] == '+' {
-		s = s[1:]
+		s, pos = s[1:], pos+1
 	}
 
-	parts := strings.Split(s, ".")
-	if len(parts) > 2 {
-		return 0, fmt.Errorf("money: invalid format: %q", s)
+	wholeStr, fracStr, _ := strings.Cut(s, ".")
+	fracPos := pos + len(wholeStr) + 1
+	if i := strings.IndexByte(fracStr, '.'); i >= 0 {
+		return fail(fracPos+i, ErrMultipleDots)
 	}
 
-	wholeStr := parts[0]
 	if wholeStr == "" {
 		wholeStr = "0"
 	}
 
-	fracStr := ""
-	if len(parts) == 2 {
-		fracStr = parts[1]
+	whole, err := parseUint(wholeStr)
+	if pe, ok := err.(*ParseError); ok {
+		return fail(pos+pe.Offset, pe.Kind)
+	}
+	for i := 0; i < len(fracStr); i++ {
+		if fracStr[i] < '0' || fracStr[i] > '9' {
+			return fail(fracPos+i, ErrNonDigit)
+		}
 	}
 
 	switch len(fracStr) {
@@ -50,49 +86,50 @@ func ParseString(s string) (Amount, error) {
 	case 2:
 		// ok
 	default:
-		return 0, fmt.Errorf("money: too many decimal places: %q", s)
-	}
-
-	whole, err := parseUint(wholeStr)
-	if err != nil {
-		return 0, fmt.Errorf("money: invalid whole part: %w", err)
-	}
-	frac, err := parseUint(fracStr)
-	if err != nil {
-		return 0, fmt.Errorf("money: invalid fractional part: %w", err)
-	}
-	if frac > 99 {
-		return 0, fmt.Errorf("money: invalid fractional part: %q", fracStr)
+		return fail(fracPos+2, ErrTooManyDecimals)
 	}
+	frac, _ := parseUint(fracStr)
 
 	// ---- overflow guard (critical) ----
-	// We need: whole*100 + frac <= MaxInt64 for positive, and <= MaxInt64 for negative magnitude too.
-	// Since sign is applied at the end, just check absolute magnitude fits into int64.
-	if whole > uint64(math.MaxInt64/100) {
-		return 0, fmt.Errorf("money: overflow: %q", s)
+	// We need: whole*100 + frac <= MaxInt64 for positive, and <= |MinInt64| for negative,
+	// so that every Amount (including math.MinInt64) round-trips through StringFixed2.
+	limit := uint64(math.MaxInt64)
+	if sign < 0 {
+		limit++
+	}
+	if whole > limit/100 {
+		return fail(pos, ErrOverflow)
 	}
-	minorBase := int64(whole) * 100
-	// minorBase is safe now, but minorBase+frac might still overflow if whole == MaxInt64/100 and frac pushes it
-	if minorBase > math.MaxInt64-int64(frac) {
-		return 0, fmt.Errorf("money: overflow: %q", s)
+	// whole*100 is safe now, but whole*100+frac might still overflow if whole == limit/100 and frac pushes it
+	if whole*100 > limit-frac {
+		return fail(pos, ErrOverflow)
 	}
-	minor := minorBase + int64(frac)
+	minor := whole*100 + frac
 	// -----------------------------------
 
-	return Amount(sign * minor), nil
+	if sign < 0 {
+		return Amount(-minor), nil
+	}
+	return Amount(minor), nil
 }
 
+// parseUint parses ASCII digits; errors are *ParseError with Offset relative to s
+// (0 for ErrOverflow, matching ParseString).
 func parseUint(s string) (uint64, error) {
 	if s == "" {
-		return 0, errors.New("money: empty number")
+		return 0, &ParseError{Input: s, Kind: ErrEmpty}
 	}
 	var n uint64
 	for i := 0; i < len(s); i++ {
 		c := s[i]
 		if c < '0' || c > '9' {
-			return 0, fmt.Errorf("money: non-digit %q", c)
+			return 0, &ParseError{Input: s, Offset: i, Kind: ErrNonDigit}
+		}
+		d := uint64(c - '0')
+		if n > (math.MaxUint64-d)/10 {
+			return 0, &ParseError{Input: s, Kind: ErrOverflow}
 		}
-		n = n*10 + uint64(c-'0')
+		n = n*10 + d
 	}
 	return n, nil
 }
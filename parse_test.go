@@ -1,6 +1,8 @@
 package money_test
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/dahaiyiyimcom/money"
@@ -76,3 +78,55 @@ func TestParseString_TrailingDot(t *testing.T) {
 		t.Fatalf("got=%d want=1200", a.Minor())
 	}
 }
+
+func TestParseString_ParseError(t *testing.T) {
+	cases := []struct {
+		in     string
+		kind   error
+		offset int
+	}{
+		{"", money.ErrEmpty, 0},
+		{"   ", money.ErrEmpty, 0},
+		{"abc", money.ErrNonDigit, 0},
+		{"  12.a", money.ErrNonDigit, 5},
+		{"--12.34", money.ErrNonDigit, 1},
+		{"12-34", money.ErrNonDigit, 2},
+		{"12.345", money.ErrTooManyDecimals, 5},
+		{" -1.2345", money.ErrTooManyDecimals, 6},
+		{"12..34", money.ErrMultipleDots, 3},
+		{"1.2.3", money.ErrMultipleDots, 3},
+		{"92233720368547759", money.ErrOverflow, 0},
+		{"-92233720368547758.09", money.ErrOverflow, 1},
+		{"18446744073709551616", money.ErrOverflow, 0}, // used to wrap around to 0
+		{" +18446744073709551616", money.ErrOverflow, 2},
+	}
+
+	for _, tc := range cases {
+		_, err := money.ParseString(tc.in)
+		if !errors.Is(err, tc.kind) {
+			t.Fatalf("in=%q got=%v want kind %v", tc.in, err, tc.kind)
+		}
+		var pe *money.ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("in=%q expected *ParseError, got %T", tc.in, err)
+		}
+		if pe.Input != tc.in || pe.Offset != tc.offset {
+			t.Fatalf("in=%q got input=%q offset=%d want offset=%d", tc.in, pe.Input, pe.Offset, tc.offset)
+		}
+	}
+}
+
+func TestParseError_Propagates(t *testing.T) {
+	var a money.Amount
+	err := json.Unmarshal([]byte(`"12.345"`), &a)
+	if !errors.Is(err, money.ErrTooManyDecimals) {
+		t.Fatalf("json: got=%v", err)
+	}
+
+	var d money.DBAmount
+	err = d.Scan([]byte("12..3"))
+	var pe *money.ParseError
+	if !errors.As(err, &pe) || pe.Kind != money.ErrMultipleDots || pe.Offset != 3 {
+		t.Fatalf("scan: got=%v", err)
+	}
+}
@@ -0,0 +1,171 @@
+// Package pricing provides quantity-break price lists (tiered unit pricing).
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+// BreakMode selects how a PriceList applies its unit prices.
+type BreakMode int
+
+const (
+	// AllUnits prices every unit at the unit price of the break the quantity falls into.
+	AllUnits BreakMode = iota
+	// Incremental prices each unit at the unit price of the break that unit falls into.
+	Incremental
+)
+
+func (m BreakMode) String() string {
+	switch m {
+	case AllUnits:
+		return "all-units"
+	case Incremental:
+		return "incremental"
+	default:
+		return fmt.Sprintf("BreakMode(%d)", int(m))
+	}
+}
+
+func (m BreakMode) MarshalJSON() ([]byte, error) {
+	if m != AllUnits && m != Incremental {
+		return nil, fmt.Errorf("pricing: invalid break mode %d", int(m))
+	}
+	return json.Marshal(m.String())
+}
+
+func (m *BreakMode) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	switch s {
+	case "all-units":
+		*m = AllUnits
+	case "incremental":
+		*m = Incremental
+	default:
+		return fmt.Errorf("pricing: invalid break mode %q", s)
+	}
+	return nil
+}
+
+// Break is a quantity range [Min, Max] with a unit price. Max == 0 means unbounded.
+type Break struct {
+	Min  int64        `json:"min"`
+	Max  int64        `json:"max,omitempty"`
+	Unit money.Amount `json:"unit"`
+}
+
+// PriceList maps quantity ranges to unit prices.
+// JSON: {"mode":"all-units","breaks":[{"min":1,"max":9,"unit":"10.00"},{"min":10,"unit":"9.00"}]}
+type PriceList struct {
+	Mode   BreakMode `json:"mode"`
+	Breaks []Break   `json:"breaks"`
+}
+
+// BreakLine is the part of a line total priced by one break.
+type BreakLine struct {
+	Break int          `json:"break"` // index into PriceList.Breaks
+	Qty   int64        `json:"qty"`
+	Unit  money.Amount `json:"unit"`
+	Total money.Amount `json:"total"`
+}
+
+// Validate checks that breaks are ordered, start at 1, have no gaps or overlaps,
+// and that only the last break is unbounded.
+func (p PriceList) Validate() error {
+	if p.Mode != AllUnits && p.Mode != Incremental {
+		return fmt.Errorf("pricing: invalid break mode %d", int(p.Mode))
+	}
+	if len(p.Breaks) == 0 {
+		return fmt.Errorf("pricing: price list has no breaks")
+	}
+	if p.Breaks[0].Min != 1 {
+		return fmt.Errorf("pricing: first break must start at 1, got %d", p.Breaks[0].Min)
+	}
+	for i, b := range p.Breaks {
+		if b.Unit < 0 {
+			return fmt.Errorf("pricing: break %d has negative unit price %s", i, b.Unit.StringFixed2())
+		}
+		if b.Max != 0 && b.Max < b.Min {
+			return fmt.Errorf("pricing: break %d has max %d below min %d", i, b.Max, b.Min)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := p.Breaks[i-1]
+		if prev.Max == 0 || b.Min <= prev.Max {
+			return fmt.Errorf("pricing: break %d overlaps break %d", i, i-1)
+		}
+		if b.Min > prev.Max+1 {
+			return fmt.Errorf("pricing: missing range %d-%d between breaks %d and %d", prev.Max+1, b.Min-1, i-1, i)
+		}
+	}
+	if last := p.Breaks[len(p.Breaks)-1]; last.Max != 0 {
+		return fmt.Errorf("pricing: quantities above %d have no price", last.Max)
+	}
+	return nil
+}
+
+// Price returns the line total for qty and its per-break breakdown.
+func (p PriceList) Price(qty int64) (money.Amount, []BreakLine, error) {
+	if err := p.Validate(); err != nil {
+		return 0, nil, err
+	}
+	if qty < 0 {
+		return 0, nil, fmt.Errorf("pricing: negative quantity %d", qty)
+	}
+	if qty == 0 {
+		return 0, nil, nil
+	}
+
+	if p.Mode == AllUnits {
+		i := p.find(qty)
+		unit := p.Breaks[i].Unit
+		total := unit.MulQty(qty)
+		return total, []BreakLine{{Break: i, Qty: qty, Unit: unit, Total: total}}, nil
+	}
+
+	var total money.Amount
+	var out []BreakLine
+	for i, b := range p.Breaks {
+		if qty < b.Min {
+			break
+		}
+		upper := qty
+		if b.Max != 0 && b.Max < qty {
+			upper = b.Max
+		}
+		n := upper - b.Min + 1
+		t := b.Unit.MulQty(n)
+		out = append(out, BreakLine{Break: i, Qty: n, Unit: b.Unit, Total: t})
+		total = total.Add(t)
+	}
+	return total, out, nil
+}
+
+func (p PriceList) find(qty int64) int {
+	for i, b := range p.Breaks {
+		if b.Max == 0 || qty <= b.Max {
+			return i
+		}
+	}
+	return len(p.Breaks) - 1
+}
+
+// UnmarshalJSON decodes and validates a price list.
+func (p *PriceList) UnmarshalJSON(b []byte) error {
+	type raw PriceList
+	var r raw
+	if err := json.Unmarshal(b, &r); err != nil {
+		return err
+	}
+	if err := PriceList(r).Validate(); err != nil {
+		return err
+	}
+	*p = PriceList(r)
+	return nil
+}
@@ -0,0 +1,118 @@
+package pricing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+	"github.com/dahaiyiyimcom/money/pricing"
+)
+
+// 1-9: 10.00 | 10-49: 9.00 | 50+: 8.00
+func testList(mode pricing.BreakMode) pricing.PriceList {
+	return pricing.PriceList{
+		Mode: mode,
+		Breaks: []pricing.Break{
+			{Min: 1, Max: 9, Unit: money.NewMinor(1000)},
+			{Min: 10, Max: 49, Unit: money.NewMinor(900)},
+			{Min: 50, Unit: money.NewMinor(800)},
+		},
+	}
+}
+
+func TestPriceList_AllUnits(t *testing.T) {
+	cases := []struct {
+		qty  int64
+		want int64
+	}{
+		{0, 0},
+		{1, 1000},
+		{9, 9000},
+		{10, 9000},
+		{49, 44100},
+		{50, 40000},
+		{120, 96000},
+	}
+	p := testList(pricing.AllUnits)
+	for _, tc := range cases {
+		got, _, err := p.Price(tc.qty)
+		if err != nil {
+			t.Fatalf("qty=%d err: %v", tc.qty, err)
+		}
+		if got.Minor() != tc.want {
+			t.Fatalf("qty=%d got=%d want=%d", tc.qty, got.Minor(), tc.want)
+		}
+	}
+}
+
+func TestPriceList_Incremental(t *testing.T) {
+	p := testList(pricing.Incremental)
+
+	// 9*10.00 + 40*9.00 + 11*8.00 = 90 + 360 + 88 = 538.00
+	total, lines, err := p.Price(60)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if total.Minor() != 53800 {
+		t.Fatalf("total got=%d want=53800", total.Minor())
+	}
+	if len(lines) != 3 {
+		t.Fatalf("len(lines)=%d want=3", len(lines))
+	}
+	wantQty := []int64{9, 40, 11}
+	for i, l := range lines {
+		if l.Qty != wantQty[i] {
+			t.Fatalf("line %d qty got=%d want=%d", i, l.Qty, wantQty[i])
+		}
+	}
+}
+
+func TestPriceList_Validate(t *testing.T) {
+	cases := map[string][]pricing.Break{
+		"empty":        nil,
+		"not from 1":   {{Min: 2, Unit: 100}},
+		"overlap":      {{Min: 1, Max: 10, Unit: 100}, {Min: 10, Unit: 90}},
+		"gap":          {{Min: 1, Max: 9, Unit: 100}, {Min: 11, Unit: 90}},
+		"bounded last": {{Min: 1, Max: 9, Unit: 100}},
+		"unbounded mid": {
+			{Min: 1, Unit: 100}, {Min: 10, Unit: 90},
+		},
+		"negative unit": {{Min: 1, Unit: -1}},
+		"max below min": {{Min: 1, Max: 5, Unit: 100}, {Min: 6, Max: 4, Unit: 90}, {Min: 5, Unit: 80}},
+	}
+	for name, breaks := range cases {
+		p := pricing.PriceList{Breaks: breaks}
+		if err := p.Validate(); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+
+	if _, _, err := testList(pricing.AllUnits).Price(-1); err == nil {
+		t.Fatalf("expected error for negative quantity")
+	}
+}
+
+func TestPriceList_JSON(t *testing.T) {
+	in := `{"mode":"incremental","breaks":[{"min":1,"max":9,"unit":"10.00"},{"min":10,"unit":"9.00"}]}`
+
+	var p pricing.PriceList
+	if err := json.Unmarshal([]byte(in), &p); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if p.Mode != pricing.Incremental || len(p.Breaks) != 2 || p.Breaks[1].Unit.Minor() != 900 {
+		t.Fatalf("unexpected list: %+v", p)
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != in {
+		t.Fatalf("got=%s want=%s", b, in)
+	}
+
+	gap := `{"mode":"all-units","breaks":[{"min":1,"max":9,"unit":"10.00"},{"min":20,"unit":"9.00"}]}`
+	if err := json.Unmarshal([]byte(gap), &p); err == nil {
+		t.Fatalf("expected validation error on load")
+	}
+}
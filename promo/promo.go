@@ -0,0 +1,217 @@
+// Package promo computes coupon and promotion discounts on basket lines.
+// Every rule returns its discount together with an exact per-line allocation,
+// and no rule may push a line total below zero.
+package promo
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+// Line is a basket line: Unit * Qty.
+type Line struct {
+	Unit money.Amount
+	Qty  int64
+}
+
+func (l Line) Total() money.Amount { return l.Unit.MulQty(l.Qty) }
+
+// Result is a discount and its per-line allocation (sum(Lines) == Discount).
+type Result struct {
+	Discount money.Amount
+	Lines    []money.Amount
+}
+
+// Rule is a promotion rule.
+type Rule interface {
+	Apply(lines []Line) (Result, error)
+}
+
+// Apply runs every rule against the same lines and sums their allocations.
+// It fails if the combined discount would make any line total negative.
+func Apply(lines []Line, rules ...Rule) (Result, []Result, error) {
+	total := Result{Lines: make([]money.Amount, len(lines))}
+	per := make([]Result, 0, len(rules))
+	for i, r := range rules {
+		res, err := r.Apply(lines)
+		if err != nil {
+			return Result{}, nil, fmt.Errorf("promo: rule %d: %w", i, err)
+		}
+		per = append(per, res)
+		total.Discount = total.Discount.Add(res.Discount)
+		for j, d := range res.Lines {
+			total.Lines[j] = total.Lines[j].Add(d)
+		}
+	}
+	if err := checkLines(lines, total.Lines); err != nil {
+		return Result{}, nil, err
+	}
+	return total, per, nil
+}
+
+type percentOff struct {
+	rate  money.Rate
+	cap   money.Amount
+	round money.RoundingMode
+}
+
+// PercentOff discounts rate of the basket, capped at cap (0 = no cap).
+// e.g. PercentOff(money.RatePercent(10), money.NewMinor(5000), money.RoundHalfUp) => 10% up to 50.00
+func PercentOff(rate money.Rate, cap money.Amount, mode money.RoundingMode) Rule {
+	return percentOff{rate: rate, cap: cap, round: mode}
+}
+
+func (r percentOff) Apply(lines []Line) (Result, error) {
+	if r.rate < 0 || r.cap < 0 {
+		return Result{}, fmt.Errorf("promo: negative percent-off rule")
+	}
+	bases, sum, err := totals(lines)
+	if err != nil {
+		return Result{}, err
+	}
+	d := sum.MulRate(r.rate, r.round)
+	if r.cap > 0 && d > r.cap {
+		d = r.cap
+	}
+	return allocate(lines, bases, sum, d)
+}
+
+type fixedOff struct {
+	amount    money.Amount
+	minBasket money.Amount
+}
+
+// FixedOff discounts a fixed amount when the basket total is at least minBasket.
+// The allocation is exact, so no rounding mode is needed.
+func FixedOff(amount, minBasket money.Amount) Rule {
+	return fixedOff{amount: amount, minBasket: minBasket}
+}
+
+func (r fixedOff) Apply(lines []Line) (Result, error) {
+	if r.amount < 0 {
+		return Result{}, fmt.Errorf("promo: negative fixed-off amount %s", r.amount.StringFixed2())
+	}
+	bases, sum, err := totals(lines)
+	if err != nil {
+		return Result{}, err
+	}
+	if sum < r.minBasket {
+		return Result{Lines: make([]money.Amount, len(lines))}, nil
+	}
+	return allocate(lines, bases, sum, r.amount)
+}
+
+type buyXGetY struct {
+	buy, get int64
+}
+
+// BuyXGetY makes the cheapest `get` units free in every group of `buy`+`get` units,
+// grouping units from the most to the least expensive.
+func BuyXGetY(buy, get int64) Rule {
+	return buyXGetY{buy: buy, get: get}
+}
+
+func (r buyXGetY) Apply(lines []Line) (Result, error) {
+	if r.buy < 0 || r.get <= 0 {
+		return Result{}, fmt.Errorf("promo: invalid buy %d get %d", r.buy, r.get)
+	}
+	if _, _, err := totals(lines); err != nil {
+		return Result{}, err
+	}
+
+	g := r.buy + r.get
+	// free(n) counts free positions in [0, n) of the price-descending unit sequence.
+	free := func(n int64) int64 {
+		return (n/g)*r.get + max(0, n%g-r.buy)
+	}
+
+	out := Result{Lines: make([]money.Amount, len(lines))}
+	var pos int64
+	for _, i := range byUnitDesc(lines) {
+		n := free(pos+lines[i].Qty) - free(pos)
+		pos += lines[i].Qty
+		out.Lines[i] = lines[i].Unit.MulQty(n)
+		out.Discount = out.Discount.Add(out.Lines[i])
+	}
+	return out, nil
+}
+
+type cheapestFree struct {
+	minUnits int64
+}
+
+// CheapestFree makes the single cheapest unit free when the basket has at least minUnits units.
+func CheapestFree(minUnits int64) Rule {
+	return cheapestFree{minUnits: minUnits}
+}
+
+func (r cheapestFree) Apply(lines []Line) (Result, error) {
+	if _, _, err := totals(lines); err != nil {
+		return Result{}, err
+	}
+
+	out := Result{Lines: make([]money.Amount, len(lines))}
+	var units int64
+	for _, l := range lines {
+		units += l.Qty
+	}
+	if units == 0 || units < r.minUnits {
+		return out, nil
+	}
+
+	order := byUnitDesc(lines)
+	for k := len(order) - 1; k >= 0; k-- {
+		if i := order[k]; lines[i].Qty > 0 {
+			out.Lines[i] = lines[i].Unit
+			out.Discount = lines[i].Unit
+			break
+		}
+	}
+	return out, nil
+}
+
+func totals(lines []Line) ([]money.Amount, money.Amount, error) {
+	bases := make([]money.Amount, len(lines))
+	var sum money.Amount
+	for i, l := range lines {
+		if l.Unit < 0 || l.Qty < 0 {
+			return nil, 0, fmt.Errorf("promo: line %d has negative unit or quantity", i)
+		}
+		bases[i] = l.Total()
+		sum = sum.Add(bases[i])
+	}
+	return bases, sum, nil
+}
+
+func allocate(lines []Line, bases []money.Amount, sum, d money.Amount) (Result, error) {
+	if d > sum {
+		return Result{}, fmt.Errorf("promo: discount %s exceeds basket total %s", d.StringFixed2(), sum.StringFixed2())
+	}
+	shares := money.AllocateProportional(bases, d)
+	if err := checkLines(lines, shares); err != nil {
+		return Result{}, err
+	}
+	return Result{Discount: d, Lines: shares}, nil
+}
+
+func checkLines(lines []Line, discounts []money.Amount) error {
+	for i, l := range lines {
+		if discounts[i] > l.Total() {
+			return fmt.Errorf("promo: line %d total %s would go negative (discount %s)",
+				i, l.Total().StringFixed2(), discounts[i].StringFixed2())
+		}
+	}
+	return nil
+}
+
+// byUnitDesc returns line indexes ordered by unit price, most expensive first (stable).
+func byUnitDesc(lines []Line) []int {
+	idx := make([]int, len(lines))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return lines[idx[a]].Unit > lines[idx[b]].Unit })
+	return idx
+}
@@ -0,0 +1,143 @@
+package promo_test
+
+import (
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+	"github.com/dahaiyiyimcom/money/promo"
+)
+
+func sum(xs []money.Amount) int64 {
+	var s int64
+	for _, x := range xs {
+		s += x.Minor()
+	}
+	return s
+}
+
+var basket = []promo.Line{
+	{Unit: money.NewMinor(10000), Qty: 2}, // 200.00
+	{Unit: money.NewMinor(4999), Qty: 1},  // 49.99
+	{Unit: money.NewMinor(1550), Qty: 3},  // 46.50
+}
+
+func TestPercentOff(t *testing.T) {
+	// 10% of 296.49 = 29.649 => 29.65 (half-up), under the 50.00 cap
+	r, err := promo.PercentOff(money.RatePercent(10), money.NewMinor(5000), money.RoundHalfUp).Apply(basket)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if r.Discount.Minor() != 2965 {
+		t.Fatalf("discount got=%d want=2965", r.Discount.Minor())
+	}
+	if sum(r.Lines) != r.Discount.Minor() {
+		t.Fatalf("sum(lines)=%d discount=%d", sum(r.Lines), r.Discount.Minor())
+	}
+
+	floor, _ := promo.PercentOff(money.RatePercent(10), 0, money.RoundFloor).Apply(basket)
+	if floor.Discount.Minor() != 2964 {
+		t.Fatalf("floor discount got=%d want=2964", floor.Discount.Minor())
+	}
+}
+
+func TestPercentOff_Cap(t *testing.T) {
+	r, err := promo.PercentOff(money.RatePercent(50), money.NewMinor(5000), money.RoundHalfUp).Apply(basket)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if r.Discount.Minor() != 5000 || sum(r.Lines) != 5000 {
+		t.Fatalf("discount got=%d sum=%d want=5000", r.Discount.Minor(), sum(r.Lines))
+	}
+}
+
+func TestFixedOff_MinBasket(t *testing.T) {
+	rule := promo.FixedOff(money.NewMinor(2500), money.NewMinor(30000))
+
+	r, err := rule.Apply(basket) // 296.49 < 300.00
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if r.Discount != 0 || len(r.Lines) != len(basket) {
+		t.Fatalf("expected no discount, got=%+v", r)
+	}
+
+	r, err = promo.FixedOff(money.NewMinor(2500), money.NewMinor(20000)).Apply(basket)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if r.Discount.Minor() != 2500 || sum(r.Lines) != 2500 {
+		t.Fatalf("discount got=%d sum=%d want=2500", r.Discount.Minor(), sum(r.Lines))
+	}
+}
+
+func TestFixedOff_ExceedsBasket(t *testing.T) {
+	lines := []promo.Line{{Unit: money.NewMinor(1000), Qty: 1}}
+	if _, err := promo.FixedOff(money.NewMinor(1001), 0).Apply(lines); err == nil {
+		t.Fatalf("expected error when discount exceeds basket")
+	}
+}
+
+func TestBuyXGetY(t *testing.T) {
+	// units desc: 100,100,49.99,15.50,15.50,15.50 => groups of 3, last of each free
+	r, err := promo.BuyXGetY(2, 1).Apply(basket)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if r.Lines[0].Minor() != 0 || r.Lines[1].Minor() != 4999 || r.Lines[2].Minor() != 1550 {
+		t.Fatalf("unexpected lines: %v", r.Lines)
+	}
+	if r.Discount.Minor() != 6549 {
+		t.Fatalf("discount got=%d want=6549", r.Discount.Minor())
+	}
+}
+
+func TestBuyXGetY_Invalid(t *testing.T) {
+	if _, err := promo.BuyXGetY(2, 0).Apply(basket); err == nil {
+		t.Fatalf("expected error for get=0")
+	}
+}
+
+func TestCheapestFree(t *testing.T) {
+	r, err := promo.CheapestFree(3).Apply(basket)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if r.Discount.Minor() != 1550 || r.Lines[2].Minor() != 1550 {
+		t.Fatalf("unexpected result: %+v", r)
+	}
+
+	r, _ = promo.CheapestFree(10).Apply(basket)
+	if r.Discount != 0 {
+		t.Fatalf("expected no discount below min units, got=%d", r.Discount.Minor())
+	}
+}
+
+func TestApply_Combined(t *testing.T) {
+	total, per, err := promo.Apply(basket,
+		promo.PercentOff(money.RatePercent(10), 0, money.RoundHalfUp),
+		promo.CheapestFree(1),
+	)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if len(per) != 2 {
+		t.Fatalf("len(per)=%d want=2", len(per))
+	}
+	if total.Discount.Minor() != per[0].Discount.Minor()+per[1].Discount.Minor() {
+		t.Fatalf("total discount mismatch: %+v", total)
+	}
+	if sum(total.Lines) != total.Discount.Minor() {
+		t.Fatalf("sum(lines)=%d discount=%d", sum(total.Lines), total.Discount.Minor())
+	}
+}
+
+func TestApply_RefusesNegativeLine(t *testing.T) {
+	lines := []promo.Line{{Unit: money.NewMinor(1000), Qty: 1}}
+	_, _, err := promo.Apply(lines,
+		promo.PercentOff(money.RatePercent(60), 0, money.RoundHalfUp),
+		promo.PercentOff(money.RatePercent(60), 0, money.RoundHalfUp),
+	)
+	if err == nil {
+		t.Fatalf("expected error for negative line total")
+	}
+}
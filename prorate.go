@@ -0,0 +1,75 @@
+package money
+
+import (
+	"fmt"
+	"time"
+)
+
+// Granularity is the unit proration counts in.
+type Granularity int
+
+const (
+	PerSecond Granularity = iota
+	PerDay                // calendar days in start's location; the change day counts as unused
+)
+
+// Prorate splits a period price over [start, end) at instant `at`.
+// unused = round(price * remaining / total), used = price - unused,
+// so used + unused == price exactly.
+func Prorate(price Amount, start, end, at time.Time, g Granularity, mode RoundingMode) (used, unused Amount, err error) {
+	total, remaining, err := periodUnits(start, end, at, g)
+	if err != nil {
+		return 0, 0, err
+	}
+	// 128-bit product: price*seconds overflows int64 for large annual prices
+	unused, ok := mulDiv(price, remaining, total, mode)
+	if !ok {
+		return 0, 0, fmt.Errorf("money: proration overflow for %s", price.StringFixed2())
+	}
+	return price.Sub(unused), unused, nil
+}
+
+// ProrateChange computes a mid-period plan change: credit is the unused part of oldPrice,
+// charge is the remaining part of newPrice.
+func ProrateChange(oldPrice, newPrice Amount, start, end, at time.Time, g Granularity, mode RoundingMode) (credit, charge Amount, err error) {
+	_, credit, err = Prorate(oldPrice, start, end, at, g, mode)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, charge, err = Prorate(newPrice, start, end, at, g, mode)
+	if err != nil {
+		return 0, 0, err
+	}
+	return credit, charge, nil
+}
+
+func periodUnits(start, end, at time.Time, g Granularity) (total, remaining int64, err error) {
+	if !end.After(start) {
+		return 0, 0, fmt.Errorf("money: period end %s not after start %s", end, start)
+	}
+	if at.Before(start) || at.After(end) {
+		return 0, 0, fmt.Errorf("money: change instant %s outside period [%s, %s]", at, start, end)
+	}
+
+	switch g {
+	case PerSecond:
+		total = int64(end.Sub(start) / time.Second)
+		remaining = int64(end.Sub(at) / time.Second)
+	case PerDay:
+		loc := start.Location()
+		total = dayNumber(end, loc) - dayNumber(start, loc)
+		remaining = dayNumber(end, loc) - dayNumber(at, loc)
+	default:
+		return 0, 0, fmt.Errorf("money: unsupported granularity %d", int(g))
+	}
+	if total <= 0 {
+		return 0, 0, fmt.Errorf("money: period shorter than one unit")
+	}
+	return total, remaining, nil
+}
+
+// dayNumber returns the calendar day index of t in loc (DST-safe).
+func dayNumber(t time.Time, loc *time.Location) int64 {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
@@ -0,0 +1,119 @@
+package money_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+var (
+	periodStart = time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd   = time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC) // 30 days
+)
+
+func TestProrate_PerDay(t *testing.T) {
+	price := money.NewMinor(9999) // 99.99
+	at := time.Date(2026, 4, 11, 15, 30, 0, 0, time.UTC)
+
+	// 20 of 30 days unused: 99.99 * 20/30 = 66.66
+	used, unused, err := money.Prorate(price, periodStart, periodEnd, at, money.PerDay, money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if unused.Minor() != 6666 || used.Minor() != 3333 {
+		t.Fatalf("used=%d unused=%d want 3333,6666", used.Minor(), unused.Minor())
+	}
+}
+
+func TestProrate_PerSecond(t *testing.T) {
+	price := money.NewMinor(100) // 1.00
+	at := periodStart.Add(10 * 24 * time.Hour)
+
+	_, floor, _ := money.Prorate(price, periodStart, periodEnd, at, money.PerSecond, money.RoundFloor)
+	_, ceil, _ := money.Prorate(price, periodStart, periodEnd, at, money.PerSecond, money.RoundCeil)
+	// 1.00 * 20/30 = 0.666...
+	if floor.Minor() != 66 || ceil.Minor() != 67 {
+		t.Fatalf("floor=%d ceil=%d want 66,67", floor.Minor(), ceil.Minor())
+	}
+}
+
+func TestProrate_SumsToPrice(t *testing.T) {
+	price := money.NewMinor(12345)
+	for s := int64(0); s <= 30*86400; s += 7919 {
+		at := periodStart.Add(time.Duration(s) * time.Second)
+		used, unused, err := money.Prorate(price, periodStart, periodEnd, at, money.PerSecond, money.RoundHalfUp)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		if used.Add(unused) != price {
+			t.Fatalf("at=%v used+unused=%d want=%d", at, used.Add(unused).Minor(), price.Minor())
+		}
+	}
+
+	// Cancelled at the start: full credit
+	_, unused, _ := money.Prorate(price, periodStart, periodEnd, periodStart, money.PerDay, money.RoundHalfUp)
+	if unused != price {
+		t.Fatalf("full credit got=%d want=%d", unused.Minor(), price.Minor())
+	}
+}
+
+func TestProrate_PerDay_DST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, loc)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, loc) // 31 days, DST switch inside
+	at := time.Date(2026, 3, 30, 0, 0, 0, 0, loc)
+
+	_, unused, err := money.Prorate(money.NewMinor(3100), start, end, at, money.PerDay, money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if unused.Minor() != 200 {
+		t.Fatalf("got=%d want=200", unused.Minor())
+	}
+}
+
+func TestProrate_LargePrice(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2026, 7, 2, 12, 0, 0, 0, time.UTC) // exactly half of 365 days
+	price := money.NewMinor(1_000_000_000_000)         // 10bn TL
+
+	used, unused, err := money.Prorate(price, start, end, at, money.PerSecond, money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if unused.Minor() != 500_000_000_000 || used.Minor() != 500_000_000_000 {
+		t.Fatalf("used=%d unused=%d want 500000000000 each", used.Minor(), unused.Minor())
+	}
+}
+
+func TestProrateChange(t *testing.T) {
+	at := time.Date(2026, 4, 16, 0, 0, 0, 0, time.UTC) // 15 of 30 days left
+
+	credit, charge, err := money.ProrateChange(money.NewMinor(10000), money.NewMinor(25000),
+		periodStart, periodEnd, at, money.PerDay, money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if credit.Minor() != 5000 || charge.Minor() != 12500 {
+		t.Fatalf("credit=%d charge=%d want 5000,12500", credit.Minor(), charge.Minor())
+	}
+}
+
+func TestProrate_Errors(t *testing.T) {
+	price := money.NewMinor(100)
+	if _, _, err := money.Prorate(price, periodEnd, periodStart, periodStart, money.PerDay, money.RoundHalfUp); err == nil {
+		t.Fatalf("expected error for end before start")
+	}
+	if _, _, err := money.Prorate(price, periodStart, periodEnd, periodEnd.Add(time.Second), money.PerDay, money.RoundHalfUp); err == nil {
+		t.Fatalf("expected error for instant after period")
+	}
+	sameDay := periodStart.Add(time.Hour)
+	if _, _, err := money.Prorate(price, periodStart, sameDay, periodStart, money.PerDay, money.RoundHalfUp); err == nil {
+		t.Fatalf("expected error for sub-day period with daily granularity")
+	}
+}
@@ -0,0 +1,40 @@
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// Conversion to and from the (units, nanos) pair of google.type.Money,
+// without depending on protobuf modules. The currency_code is up to the caller.
+
+const nanosPerMinor = 10_000_000 // 1 kuruş = 0.01 = 10^7 nanos
+
+// UnitsNanos returns the google.type.Money units and nanos of the amount.
+// Both have the same sign: -12.34 => (-12, -340000000).
+func (a Amount) UnitsNanos() (units int64, nanos int32) {
+	return int64(a) / 100, int32(int64(a)%100) * nanosPerMinor
+}
+
+// FromUnitsNanos converts google.type.Money units and nanos to an Amount.
+// It rejects nanos outside ±999,999,999, nanos whose sign disagrees with units,
+// and nanos that are not a multiple of 10,000,000 (sub-kuruş precision).
+func FromUnitsNanos(units int64, nanos int32) (Amount, error) {
+	if nanos <= -1_000_000_000 || nanos >= 1_000_000_000 {
+		return 0, fmt.Errorf("money: nanos %d out of range", nanos)
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return 0, fmt.Errorf("money: units %d and nanos %d have different signs", units, nanos)
+	}
+	if nanos%nanosPerMinor != 0 {
+		return 0, fmt.Errorf("money: nanos %d lose sub-kuruş precision", nanos)
+	}
+	if units > math.MaxInt64/100 || units < math.MinInt64/100 {
+		return 0, fmt.Errorf("money: overflow: units %d", units)
+	}
+	base, frac := units*100, int64(nanos/nanosPerMinor)
+	if (frac > 0 && base > math.MaxInt64-frac) || (frac < 0 && base < math.MinInt64-frac) {
+		return 0, fmt.Errorf("money: overflow: units %d nanos %d", units, nanos)
+	}
+	return Amount(base + frac), nil
+}
@@ -0,0 +1,60 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmount_UnitsNanos(t *testing.T) {
+	cases := []struct {
+		minor int64
+		units int64
+		nanos int32
+	}{
+		{0, 0, 0},
+		{1234, 12, 340_000_000},
+		{-1234, -12, -340_000_000},
+		{-50, 0, -500_000_000},
+		{1, 0, 10_000_000},
+		{math.MaxInt64, 92233720368547758, 70_000_000},
+		{math.MinInt64, -92233720368547758, -80_000_000},
+	}
+	for _, tc := range cases {
+		u, n := money.NewMinor(tc.minor).UnitsNanos()
+		if u != tc.units || n != tc.nanos {
+			t.Fatalf("minor=%d got=(%d,%d) want=(%d,%d)", tc.minor, u, n, tc.units, tc.nanos)
+		}
+		back, err := money.FromUnitsNanos(u, n)
+		if err != nil {
+			t.Fatalf("minor=%d round-trip err: %v", tc.minor, err)
+		}
+		if back.Minor() != tc.minor {
+			t.Fatalf("minor=%d round-trip got=%d", tc.minor, back.Minor())
+		}
+	}
+}
+
+func TestFromUnitsNanos_Errors(t *testing.T) {
+	cases := []struct {
+		units int64
+		nanos int32
+	}{
+		{12, 345_000_000 + 1},             // sub-kuruş
+		{0, 5_000_000},                    // half a kuruş
+		{12, -340_000_000},                // sign mismatch
+		{-12, 340_000_000},                // sign mismatch
+		{0, 1_000_000_000},                // out of range
+		{0, -1_000_000_000},               // out of range
+		{92233720368547759, 0},            // overflow
+		{-92233720368547759, 0},           // overflow
+		{92233720368547758, 80_000_000},   // MaxInt64 + 1
+		{-92233720368547758, -90_000_000}, // MinInt64 - 1
+	}
+	for _, tc := range cases {
+		if _, err := money.FromUnitsNanos(tc.units, tc.nanos); err == nil {
+			t.Fatalf("(%d,%d) expected error", tc.units, tc.nanos)
+		}
+	}
+}
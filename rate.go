@@ -0,0 +1,109 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Rate is a fractional rate in parts per million (ppm).
+// 18% => 180000, 12.5% => 125000, 0.0001% => 1
+type Rate int64
+
+const rateScale = 1_000_000 // ppm per 1.0 (100%)
+
+func NewRatePPM(ppm int64) Rate { return Rate(ppm) }
+func (r Rate) PPM() int64       { return int64(r) }
+
+// RatePercent returns a whole-percent rate (e.g. RatePercent(18) => 18%).
+func RatePercent(percent int64) Rate { return Rate(percent * rateScale / 100) }
+
+// ParseRate parses a percent string with max 4 fractional digits.
+// Examples:
+// "18" -> 18%
+// "12.5" -> 12.5%
+// "0.0001" -> 1 ppm
+func ParseRate(s string) (Rate, error) {
+	in := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("money: empty rate")
+	}
+
+	sign := int64(1)
+	if s[0] == '-' {
+		sign = -1
+		s = s[1:]
+	} else if s[0] == '+' {
+		s = s[1:]
+	}
+
+	wholeStr, fracStr, _ := strings.Cut(s, ".")
+	if wholeStr == "" {
+		wholeStr = "0"
+	}
+	if len(fracStr) > 4 {
+		return 0, fmt.Errorf("money: too many rate decimal places: %q", s)
+	}
+	fracStr += strings.Repeat("0", 4-len(fracStr))
+
+	// parseUint's *ParseError describes a fragment, not the input; do not wrap it.
+	whole, err := parseUint(wholeStr)
+	if err != nil {
+		return 0, fmt.Errorf("money: invalid rate: %q", in)
+	}
+	frac, err := parseUint(fracStr)
+	if err != nil {
+		return 0, fmt.Errorf("money: invalid rate: %q", in)
+	}
+	if whole > uint64(math.MaxInt64/10000-1) {
+		return 0, fmt.Errorf("money: rate overflow: %q", s)
+	}
+
+	return Rate(sign * (int64(whole)*10000 + int64(frac))), nil
+}
+
+// String formats the rate as a percent without trailing zeros ("18", "12.5").
+func (r Rate) String() string {
+	v := int64(r)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	whole := v / 10000
+	frac := v % 10000
+	if frac == 0 {
+		return fmt.Sprintf("%s%d", sign, whole)
+	}
+	return sign + strings.TrimRight(fmt.Sprintf("%d.%04d", whole, frac), "0")
+}
+
+// MulRate computes round(a * r). The product is computed in 128 bits, so any
+// amount times a rate up to 100% is exact; it panics if the result overflows.
+func (a Amount) MulRate(r Rate, mode RoundingMode) Amount {
+	out, ok := mulDiv(a, int64(r), rateScale, mode)
+	if !ok {
+		panic("money: MulRate overflow")
+	}
+	return out
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	// JSON output: "12.5" (percent)
+	return json.Marshal(r.String())
+}
+
+func (r *Rate) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
@@ -0,0 +1,136 @@
+package money_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestParseRate_OK(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"0", 0},
+		{"18", 180000},
+		{"12.5", 125000},
+		{"7.75", 77500},
+		{"0.0001", 1},
+		{" 100 ", 1000000},
+		{"-2.5", -25000},
+		{".5", 5000},
+	}
+
+	for _, tc := range cases {
+		r, err := money.ParseRate(tc.in)
+		if err != nil {
+			t.Fatalf("in=%q unexpected err: %v", tc.in, err)
+		}
+		if got := r.PPM(); got != tc.want {
+			t.Fatalf("in=%q got=%d want=%d", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestParseRate_Errors(t *testing.T) {
+	for _, in := range []string{"", "abc", "1.00001", "1.2.3", "%18"} {
+		if _, err := money.ParseRate(in); err == nil {
+			t.Fatalf("in=%q expected error, got nil", in)
+		}
+	}
+}
+
+func TestParseRate_ErrorNotFragment(t *testing.T) {
+	_, err := money.ParseRate("1x.5")
+	var pe *money.ParseError
+	if err == nil || errors.As(err, &pe) {
+		t.Fatalf("got=%v; must not expose a ParseError for a fragment", err)
+	}
+	if !strings.Contains(err.Error(), `"1x.5"`) {
+		t.Fatalf("error should name the full input: %v", err)
+	}
+}
+
+func TestRate_String(t *testing.T) {
+	cases := []struct {
+		ppm  int64
+		want string
+	}{
+		{0, "0"},
+		{180000, "18"},
+		{125000, "12.5"},
+		{1, "0.0001"},
+		{-25000, "-2.5"},
+	}
+	for _, tc := range cases {
+		if got := money.NewRatePPM(tc.ppm).String(); got != tc.want {
+			t.Fatalf("ppm=%d got=%q want=%q", tc.ppm, got, tc.want)
+		}
+	}
+}
+
+func TestAmount_MulRate(t *testing.T) {
+	a := money.NewMinor(1234) // 12.34
+	if got := a.MulRate(money.RatePercent(18), money.RoundHalfUp).Minor(); got != 222 {
+		t.Fatalf("got=%d want=222", got)
+	}
+	// 12.34 * 12.5% = 1.5425 => 154
+	if got := a.MulRate(money.NewRatePPM(125000), money.RoundHalfUp).Minor(); got != 154 {
+		t.Fatalf("got=%d want=154", got)
+	}
+}
+
+func TestAmount_MulRate_MatchesMulRatio(t *testing.T) {
+	for _, minor := range []int64{0, 1, -1, 5, -5, 149, -149, 150, -150, 1234, -987654} {
+		for _, ppm := range []int64{0, 1, 125000, 333333, -200000, 1000000} {
+			for _, mode := range []money.RoundingMode{money.RoundHalfUp, money.RoundFloor, money.RoundCeil} {
+				a := money.NewMinor(minor)
+				if got, want := a.MulRate(money.NewRatePPM(ppm), mode), a.MulRatio(ppm, 1_000_000, mode); got != want {
+					t.Fatalf("minor=%d ppm=%d mode=%d got=%d want=%d", minor, ppm, mode, got.Minor(), want.Minor())
+				}
+			}
+		}
+	}
+}
+
+func TestAmount_MulRate_Large(t *testing.T) {
+	// a*ppm overflows int64 here; the result does not
+	if got := money.NewMinor(1e14).MulRate(money.RatePercent(20), money.RoundHalfUp).Minor(); got != 2e13 {
+		t.Fatalf("got=%d want=%d", got, int64(2e13))
+	}
+	if got := money.NewMinor(math.MinInt64).MulRate(money.RatePercent(100), money.RoundHalfUp).Minor(); got != math.MinInt64 {
+		t.Fatalf("got=%d want=%d", got, int64(math.MinInt64))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic on overflowing result")
+		}
+	}()
+	money.NewMinor(math.MaxInt64).MulRate(money.RatePercent(200), money.RoundHalfUp)
+}
+
+func TestRate_JSON(t *testing.T) {
+	b, err := json.Marshal(money.NewRatePPM(125000))
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != `"12.5"` {
+		t.Fatalf("got=%s want=%s", b, `"12.5"`)
+	}
+
+	var r money.Rate
+	if err := json.Unmarshal([]byte(`"7.75"`), &r); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if r.PPM() != 77500 {
+		t.Fatalf("got=%d want=77500", r.PPM())
+	}
+	if err := json.Unmarshal([]byte(`7.75`), &r); err == nil {
+		t.Fatalf("expected error for number")
+	}
+}
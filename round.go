@@ -1,5 +1,10 @@
 package money
 
+import (
+	"math"
+	"math/bits"
+)
+
 type RoundingMode int
 
 const (
@@ -54,3 +59,56 @@ func (a Amount) MulRatio(num, den int64, mode RoundingMode) Amount {
 func (a Amount) Percent(percent int64, mode RoundingMode) Amount {
 	return a.MulRatio(percent, 100, mode)
 }
+
+// mulDiv computes round(a * num / den) with a 128-bit intermediate product.
+// ok is false when the result does not fit in an Amount.
+func mulDiv(a Amount, num, den int64, mode RoundingMode) (_ Amount, ok bool) {
+	if den == 0 {
+		panic("denominator cannot be zero")
+	}
+	neg := (a < 0) != (num < 0) != (den < 0)
+	hi, lo := bits.Mul64(abs64(int64(a)), abs64(num))
+	d := abs64(den)
+	if hi >= d {
+		return 0, false
+	}
+	q, r := bits.Div64(hi, lo, d)
+
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++ // |MinInt64|
+	}
+	if q > limit {
+		return 0, false
+	}
+	if r != 0 {
+		switch mode {
+		case RoundFloor:
+			if neg {
+				q++
+			}
+		case RoundCeil:
+			if !neg {
+				q++
+			}
+		case RoundHalfUp:
+			if r >= d-r {
+				q++
+			}
+		}
+		if q > limit {
+			return 0, false
+		}
+	}
+	if neg {
+		return Amount(int64(-q)), true
+	}
+	return Amount(int64(q)), true
+}
+
+func abs64(v int64) uint64 {
+	if v < 0 {
+		return -uint64(v)
+	}
+	return uint64(v)
+}
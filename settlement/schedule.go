@@ -0,0 +1,152 @@
+package settlement
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+// TierMode selects how a Schedule applies its tier rates.
+type TierMode int
+
+const (
+	// Marginal applies each tier's rate only to the slice of volume inside that tier.
+	Marginal TierMode = iota
+	// TotalVolume applies the rate of the highest reached tier to the whole volume.
+	TotalVolume
+)
+
+func (m TierMode) String() string {
+	switch m {
+	case Marginal:
+		return "marginal"
+	case TotalVolume:
+		return "total-volume"
+	default:
+		return fmt.Sprintf("TierMode(%d)", int(m))
+	}
+}
+
+func (m TierMode) MarshalJSON() ([]byte, error) {
+	if m != Marginal && m != TotalVolume {
+		return nil, fmt.Errorf("settlement: invalid tier mode %d", int(m))
+	}
+	return json.Marshal(m.String())
+}
+
+func (m *TierMode) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	switch s {
+	case "marginal":
+		*m = Marginal
+	case "total-volume":
+		*m = TotalVolume
+	default:
+		return fmt.Errorf("settlement: invalid tier mode %q", s)
+	}
+	return nil
+}
+
+// Tier starts at From (inclusive) and lasts until the next tier's From.
+type Tier struct {
+	From money.Amount `json:"from"`
+	Rate money.Rate   `json:"rate"`
+}
+
+// Schedule is a GMV-tiered commission schedule.
+// JSON: {"mode":"marginal","tiers":[{"from":"0.00","rate":"15"},{"from":"100000.00","rate":"12.5"}]}
+type Schedule struct {
+	Mode  TierMode `json:"mode"`
+	Tiers []Tier   `json:"tiers"`
+}
+
+// TierCommission is the commission computed for one tier.
+type TierCommission struct {
+	Tier       int          `json:"tier"` // index into Schedule.Tiers
+	Base       money.Amount `json:"base"` // volume the rate was applied to
+	Rate       money.Rate   `json:"rate"`
+	Commission money.Amount `json:"commission"`
+}
+
+// Validate checks that tiers start at zero, thresholds strictly increase and
+// rates are within [0, 100%].
+func (s Schedule) Validate() error {
+	if s.Mode != Marginal && s.Mode != TotalVolume {
+		return fmt.Errorf("settlement: invalid tier mode %d", int(s.Mode))
+	}
+	if len(s.Tiers) == 0 {
+		return fmt.Errorf("settlement: schedule has no tiers")
+	}
+	if s.Tiers[0].From != 0 {
+		return fmt.Errorf("settlement: first tier must start at 0.00, got %s", s.Tiers[0].From.StringFixed2())
+	}
+	for i := 1; i < len(s.Tiers); i++ {
+		if s.Tiers[i].From <= s.Tiers[i-1].From {
+			return fmt.Errorf("settlement: tier %d threshold %s not above tier %d threshold %s",
+				i, s.Tiers[i].From.StringFixed2(), i-1, s.Tiers[i-1].From.StringFixed2())
+		}
+	}
+	for i, t := range s.Tiers {
+		if err := checkRate(fmt.Sprintf("tier %d", i), t.Rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes and validates a schedule.
+func (s *Schedule) UnmarshalJSON(b []byte) error {
+	type raw Schedule
+	var r raw
+	if err := json.Unmarshal(b, &r); err != nil {
+		return err
+	}
+	if err := Schedule(r).Validate(); err != nil {
+		return err
+	}
+	*s = Schedule(r)
+	return nil
+}
+
+// Commission computes the commission for `volume` and its per-tier breakdown.
+// Each tier's commission is rounded with `mode`; the total is their exact sum.
+func (s Schedule) Commission(volume money.Amount, mode money.RoundingMode) (money.Amount, []TierCommission, error) {
+	if err := s.Validate(); err != nil {
+		return 0, nil, err
+	}
+	if volume < 0 {
+		return 0, nil, fmt.Errorf("settlement: negative volume %s", volume.StringFixed2())
+	}
+
+	reached := 0
+	for i, t := range s.Tiers {
+		if volume >= t.From {
+			reached = i
+		}
+	}
+
+	if s.Mode == TotalVolume {
+		t := s.Tiers[reached]
+		c := volume.MulRate(t.Rate, mode)
+		return c, []TierCommission{{Tier: reached, Base: volume, Rate: t.Rate, Commission: c}}, nil
+	}
+
+	var total money.Amount
+	out := make([]TierCommission, 0, reached+1)
+	for i := 0; i <= reached; i++ {
+		t := s.Tiers[i]
+		upper := volume
+		if i < reached {
+			upper = s.Tiers[i+1].From
+		}
+		base := upper.Sub(t.From)
+		c := base.MulRate(t.Rate, mode)
+		out = append(out, TierCommission{Tier: i, Base: base, Rate: t.Rate, Commission: c})
+		total = total.Add(c)
+	}
+	return total, out, nil
+}
@@ -0,0 +1,144 @@
+package settlement_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+	"github.com/dahaiyiyimcom/money/settlement"
+)
+
+// 0 - 100,000: 15% | 100,000 - 500,000: 12.5% | 500,000+: 10%
+func testSchedule(mode settlement.TierMode) settlement.Schedule {
+	return settlement.Schedule{
+		Mode: mode,
+		Tiers: []settlement.Tier{
+			{From: money.NewMinor(0), Rate: money.RatePercent(15)},
+			{From: money.NewMinor(10_000_000), Rate: money.NewRatePPM(125000)},
+			{From: money.NewMinor(50_000_000), Rate: money.RatePercent(10)},
+		},
+	}
+}
+
+func TestSchedule_Marginal(t *testing.T) {
+	s := testSchedule(settlement.Marginal)
+
+	// 200,000.00 => 100,000*15% + 100,000*12.5% = 15,000 + 12,500
+	total, parts, err := s.Commission(money.NewMinor(20_000_000), money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if total.Minor() != 2_750_000 {
+		t.Fatalf("total got=%d want=2750000", total.Minor())
+	}
+	if len(parts) != 2 {
+		t.Fatalf("len(parts)=%d want=2", len(parts))
+	}
+	if parts[0].Base.Minor() != 10_000_000 || parts[1].Base.Minor() != 10_000_000 {
+		t.Fatalf("unexpected bases: %+v", parts)
+	}
+	var sum int64
+	for _, p := range parts {
+		sum += p.Commission.Minor()
+	}
+	if sum != total.Minor() {
+		t.Fatalf("sum(parts)=%d total=%d", sum, total.Minor())
+	}
+}
+
+func TestSchedule_TotalVolume(t *testing.T) {
+	s := testSchedule(settlement.TotalVolume)
+
+	// 600,000.00 reaches the 10% tier
+	total, parts, err := s.Commission(money.NewMinor(60_000_000), money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if total.Minor() != 6_000_000 {
+		t.Fatalf("total got=%d want=6000000", total.Minor())
+	}
+	if len(parts) != 1 || parts[0].Tier != 2 {
+		t.Fatalf("unexpected parts: %+v", parts)
+	}
+}
+
+func TestSchedule_ThresholdBoundary(t *testing.T) {
+	s := testSchedule(settlement.TotalVolume)
+
+	// exactly 100,000.00 is in the second tier (From is inclusive)
+	_, parts, err := s.Commission(money.NewMinor(10_000_000), money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if parts[0].Tier != 1 {
+		t.Fatalf("tier got=%d want=1", parts[0].Tier)
+	}
+}
+
+func TestSchedule_Rounding(t *testing.T) {
+	s := settlement.Schedule{Tiers: []settlement.Tier{{Rate: money.NewRatePPM(125000)}}}
+
+	// 0.05 * 12.5% = 0.00625
+	floor, _, _ := s.Commission(money.NewMinor(5), money.RoundFloor)
+	ceil, _, _ := s.Commission(money.NewMinor(5), money.RoundCeil)
+	if floor.Minor() != 0 || ceil.Minor() != 1 {
+		t.Fatalf("floor=%d ceil=%d want 0,1", floor.Minor(), ceil.Minor())
+	}
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	cases := []settlement.Schedule{
+		{},
+		{Tiers: []settlement.Tier{{From: money.NewMinor(100)}}},
+		{Tiers: []settlement.Tier{{From: 0}, {From: money.NewMinor(100)}, {From: money.NewMinor(100)}}},
+		{Mode: settlement.TierMode(9), Tiers: []settlement.Tier{{From: 0}}},
+	}
+	for i, s := range cases {
+		if err := s.Validate(); err == nil {
+			t.Fatalf("case %d: expected error", i)
+		}
+	}
+
+	if _, _, err := testSchedule(settlement.Marginal).Commission(money.NewMinor(-1), money.RoundHalfUp); err == nil {
+		t.Fatalf("expected error for negative volume")
+	}
+}
+
+func TestSchedule_JSON(t *testing.T) {
+	in := `{"mode":"marginal","tiers":[{"from":"0.00","rate":"15"},{"from":"100000.00","rate":"12.5"}]}`
+
+	var s settlement.Schedule
+	if err := json.Unmarshal([]byte(in), &s); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if s.Mode != settlement.Marginal || s.Tiers[1].From.Minor() != 10_000_000 || s.Tiers[1].Rate.PPM() != 125000 {
+		t.Fatalf("unexpected schedule: %+v", s)
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != in {
+		t.Fatalf("got=%s want=%s", b, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"mode":"flat"}`), &s); err == nil {
+		t.Fatalf("expected error for unknown mode")
+	}
+}
+
+func TestSchedule_UnmarshalJSON_Validates(t *testing.T) {
+	for _, in := range []string{
+		`{"mode":"marginal","tiers":[]}`,
+		`{"mode":"marginal","tiers":[{"from":"10.00","rate":"15"}]}`,                                                                // not starting at 0
+		`{"mode":"marginal","tiers":[{"from":"0.00","rate":"15"},{"from":"0.00","rate":"12"}]}`,                                     // overlapping
+		`{"mode":"total-volume","tiers":[{"from":"0.00","rate":"15"},{"from":"500.00","rate":"12"},{"from":"100.00","rate":"10"}]}`, // unordered
+		`{"mode":"marginal","tiers":[{"from":"0.00","rate":"150"}]}`,                                                                // rate above 100%
+	} {
+		var s settlement.Schedule
+		if err := json.Unmarshal([]byte(in), &s); err == nil {
+			t.Fatalf("in=%s expected error", in)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Package settlement computes marketplace seller payouts:
+// gross → commission → VAT on commission → fees → payout.
+// Every breakdown reconciles exactly: Gross == Commission + CommissionVAT + Fees + Payout.
+package settlement
+
+import (
+	"fmt"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+// Policy holds the platform-wide settlement parameters.
+type Policy struct {
+	CommissionVAT money.Rate // VAT charged on the commission (e.g. 20%)
+	Rounding      money.RoundingMode
+}
+
+// Line is a single sold item (or item group) in an order.
+type Line struct {
+	Gross          money.Amount // sale price paid by the customer
+	CommissionRate money.Rate   // category commission rate
+	Fee            money.Amount // line-level fees (e.g. service fee)
+}
+
+// Order is a set of lines with an order-level cargo fee.
+// The cargo fee is allocated across lines proportionally to Gross.
+type Order struct {
+	Lines    []Line
+	CargoFee money.Amount
+}
+
+// Breakdown is the settlement of a line or order.
+type Breakdown struct {
+	Gross         money.Amount `json:"gross"`
+	Commission    money.Amount `json:"commission"`
+	CommissionVAT money.Amount `json:"commissionVat"`
+	Fees          money.Amount `json:"fees"`
+	Payout        money.Amount `json:"payout"`
+}
+
+// OrderSettlement holds per-line breakdowns and their total.
+type OrderSettlement struct {
+	Lines []Breakdown `json:"lines"`
+	Total Breakdown   `json:"total"`
+}
+
+// Line settles a single line. Payout absorbs all rounding, so the result always reconciles.
+// Commission and VAT rates must be within [0, 100%].
+func (p Policy) Line(l Line) (Breakdown, error) {
+	return p.settle(l, l.Fee)
+}
+
+// Order settles every line and allocates the cargo fee with an exact sum.
+// When every line has zero gross (e.g. free samples), the cargo fee is split evenly.
+func (p Policy) Order(o Order) (OrderSettlement, error) {
+	if len(o.Lines) == 0 && o.CargoFee != 0 {
+		return OrderSettlement{}, fmt.Errorf("settlement: cargo fee %s on an order without lines", o.CargoFee.StringFixed2())
+	}
+
+	var total money.Amount
+	bases := make([]money.Amount, len(o.Lines))
+	for i, l := range o.Lines {
+		bases[i] = l.Gross
+		total = total.Add(l.Gross)
+	}
+	if total == 0 {
+		for i := range bases {
+			bases[i] = money.NewMinor(1)
+		}
+	}
+	cargo := money.AllocateProportional(bases, o.CargoFee)
+
+	out := OrderSettlement{Lines: make([]Breakdown, len(o.Lines))}
+	for i, l := range o.Lines {
+		b, err := p.settle(l, l.Fee.Add(cargo[i]))
+		if err != nil {
+			return OrderSettlement{}, fmt.Errorf("settlement: line %d: %w", i, err)
+		}
+		out.Lines[i] = b
+		out.Total = out.Total.Add(b)
+	}
+	return out, nil
+}
+
+func (p Policy) settle(l Line, fees money.Amount) (Breakdown, error) {
+	// Rates within [0, 100%] keep MulRate from overflowing and payout from exceeding gross.
+	if err := checkRate("commission", l.CommissionRate); err != nil {
+		return Breakdown{}, err
+	}
+	if err := checkRate("commission VAT", p.CommissionVAT); err != nil {
+		return Breakdown{}, err
+	}
+	commission := l.Gross.MulRate(l.CommissionRate, p.Rounding)
+	vat := commission.MulRate(p.CommissionVAT, p.Rounding)
+	return Breakdown{
+		Gross:         l.Gross,
+		Commission:    commission,
+		CommissionVAT: vat,
+		Fees:          fees,
+		Payout:        l.Gross.Sub(commission).Sub(vat).Sub(fees),
+	}, nil
+}
+
+func checkRate(name string, r money.Rate) error {
+	if r < 0 || r > money.RatePercent(100) {
+		return fmt.Errorf("settlement: %s rate %s%% outside [0, 100]", name, r)
+	}
+	return nil
+}
+
+// Reconciles reports whether Gross == Commission + CommissionVAT + Fees + Payout.
+func (b Breakdown) Reconciles() bool {
+	return b.Gross == b.Commission.Add(b.CommissionVAT).Add(b.Fees).Add(b.Payout)
+}
+
+func (b Breakdown) Add(o Breakdown) Breakdown {
+	return Breakdown{
+		Gross:         b.Gross.Add(o.Gross),
+		Commission:    b.Commission.Add(o.Commission),
+		CommissionVAT: b.CommissionVAT.Add(o.CommissionVAT),
+		Fees:          b.Fees.Add(o.Fees),
+		Payout:        b.Payout.Add(o.Payout),
+	}
+}
+
+// Refund returns the (negative) reversal of `amount` out of a settled breakdown.
+// Every component is reversed in proportion to its share of Gross, with an exact sum.
+// Pass the net settlement (original plus prior refunds) so that refunding the
+// remaining gross reverses every component to exactly zero.
+func (b Breakdown) Refund(amount money.Amount) (Breakdown, error) {
+	if amount < 0 {
+		return Breakdown{}, fmt.Errorf("settlement: negative refund %s", amount.StringFixed2())
+	}
+	if amount > b.Gross {
+		return Breakdown{}, fmt.Errorf("settlement: refund %s exceeds settled gross %s",
+			amount.StringFixed2(), b.Gross.StringFixed2())
+	}
+	if amount == b.Gross {
+		return Breakdown{}.sub(b), nil
+	}
+
+	shares := money.AllocateProportional(
+		[]money.Amount{b.Commission, b.CommissionVAT, b.Fees, b.Payout},
+		amount,
+	)
+	return Breakdown{
+		Gross:         -amount,
+		Commission:    -shares[0],
+		CommissionVAT: -shares[1],
+		Fees:          -shares[2],
+		Payout:        -shares[3],
+	}, nil
+}
+
+func (b Breakdown) sub(o Breakdown) Breakdown {
+	return Breakdown{
+		Gross:         b.Gross.Sub(o.Gross),
+		Commission:    b.Commission.Sub(o.Commission),
+		CommissionVAT: b.CommissionVAT.Sub(o.CommissionVAT),
+		Fees:          b.Fees.Sub(o.Fees),
+		Payout:        b.Payout.Sub(o.Payout),
+	}
+}
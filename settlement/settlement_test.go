@@ -0,0 +1,173 @@
+package settlement_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+	"github.com/dahaiyiyimcom/money/settlement"
+)
+
+var policy = settlement.Policy{
+	CommissionVAT: money.RatePercent(20),
+	Rounding:      money.RoundHalfUp,
+}
+
+func TestPolicy_Line(t *testing.T) {
+	b, err := policy.Line(settlement.Line{
+		Gross:          money.NewMinor(19999),    // 199.99
+		CommissionRate: money.NewRatePPM(125000), // 12.5%
+		Fee:            money.NewMinor(500),      // 5.00
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// 199.99 * 12.5% = 24.99875 => 25.00; VAT 20% => 5.00
+	if b.Commission.Minor() != 2500 {
+		t.Fatalf("commission got=%d want=2500", b.Commission.Minor())
+	}
+	if b.CommissionVAT.Minor() != 500 {
+		t.Fatalf("vat got=%d want=500", b.CommissionVAT.Minor())
+	}
+	if b.Payout.Minor() != 19999-2500-500-500 {
+		t.Fatalf("payout got=%d want=%d", b.Payout.Minor(), 19999-2500-500-500)
+	}
+	if !b.Reconciles() {
+		t.Fatalf("breakdown does not reconcile: %+v", b)
+	}
+}
+
+func TestPolicy_Order_CargoAllocation(t *testing.T) {
+	o := settlement.Order{
+		Lines: []settlement.Line{
+			{Gross: money.NewMinor(10000), CommissionRate: money.RatePercent(10)},
+			{Gross: money.NewMinor(20000), CommissionRate: money.RatePercent(15)},
+			{Gross: money.NewMinor(30000), CommissionRate: money.NewRatePPM(87500)},
+		},
+		CargoFee: money.NewMinor(3001), // 30.01, does not split evenly
+	}
+
+	s, err := policy.Order(o)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if len(s.Lines) != 3 {
+		t.Fatalf("len(lines)=%d want=3", len(s.Lines))
+	}
+	var fees, gross int64
+	for i, b := range s.Lines {
+		if !b.Reconciles() {
+			t.Fatalf("line %d does not reconcile: %+v", i, b)
+		}
+		fees += b.Fees.Minor()
+		gross += b.Gross.Minor()
+	}
+	if fees != 3001 {
+		t.Fatalf("sum(fees)=%d want=3001", fees)
+	}
+	if gross != s.Total.Gross.Minor() {
+		t.Fatalf("total gross=%d want=%d", s.Total.Gross.Minor(), gross)
+	}
+	if !s.Total.Reconciles() {
+		t.Fatalf("total does not reconcile: %+v", s.Total)
+	}
+}
+
+func TestPolicy_Order_ZeroGross(t *testing.T) {
+	o := settlement.Order{
+		Lines: []settlement.Line{
+			{Gross: money.NewMinor(0), CommissionRate: money.RatePercent(10)},
+			{Gross: money.NewMinor(0), CommissionRate: money.RatePercent(10)},
+		},
+		CargoFee: money.NewMinor(2999),
+	}
+
+	s, err := policy.Order(o)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if s.Total.Fees.Minor() != 2999 || s.Lines[0].Fees.Minor()+s.Lines[1].Fees.Minor() != 2999 {
+		t.Fatalf("cargo fee lost: %+v", s)
+	}
+	if !s.Total.Reconciles() {
+		t.Fatalf("total does not reconcile: %+v", s.Total)
+	}
+
+	if _, err := policy.Order(settlement.Order{CargoFee: money.NewMinor(2999)}); err == nil {
+		t.Fatalf("expected error for cargo fee without lines")
+	}
+}
+
+func TestPolicy_RateBounds(t *testing.T) {
+	big := money.NewMinor(math.MaxInt64 / 2)
+	cases := []struct {
+		p settlement.Policy
+		l settlement.Line
+	}{
+		{policy, settlement.Line{Gross: big, CommissionRate: money.RatePercent(250)}}, // would overflow MulRate
+		{policy, settlement.Line{Gross: money.NewMinor(1000), CommissionRate: money.RatePercent(-5)}},
+		{settlement.Policy{CommissionVAT: money.RatePercent(101)}, settlement.Line{Gross: money.NewMinor(1000)}},
+		{settlement.Policy{CommissionVAT: money.RatePercent(-1)}, settlement.Line{Gross: money.NewMinor(1000)}},
+	}
+	for i, tc := range cases {
+		if _, err := tc.p.Line(tc.l); err == nil {
+			t.Fatalf("case %d: Line expected error", i)
+		}
+		if _, err := tc.p.Order(settlement.Order{Lines: []settlement.Line{tc.l}}); err == nil {
+			t.Fatalf("case %d: Order expected error", i)
+		}
+	}
+
+	// 100% is allowed
+	b, err := policy.Line(settlement.Line{Gross: big, CommissionRate: money.RatePercent(100)})
+	if err != nil || !b.Reconciles() {
+		t.Fatalf("100%%: got=%+v err=%v", b, err)
+	}
+}
+
+func TestBreakdown_Refund_PartialThenRest(t *testing.T) {
+	orig, err := policy.Line(settlement.Line{
+		Gross:          money.NewMinor(9999),
+		CommissionRate: money.NewRatePPM(133300),
+		Fee:            money.NewMinor(299),
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	r1, err := orig.Refund(money.NewMinor(3333))
+	if err != nil {
+		t.Fatalf("refund err: %v", err)
+	}
+	if !r1.Reconciles() {
+		t.Fatalf("refund does not reconcile: %+v", r1)
+	}
+	if r1.Gross.Minor() != -3333 {
+		t.Fatalf("refund gross got=%d want=-3333", r1.Gross.Minor())
+	}
+
+	net := orig.Add(r1)
+	r2, err := net.Refund(net.Gross)
+	if err != nil {
+		t.Fatalf("refund err: %v", err)
+	}
+	if final := net.Add(r2); final != (settlement.Breakdown{}) {
+		t.Fatalf("expected zero after full reversal, got=%+v", final)
+	}
+}
+
+func TestBreakdown_Refund_Errors(t *testing.T) {
+	b, err := policy.Line(settlement.Line{Gross: money.NewMinor(1000), CommissionRate: money.RatePercent(10)})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if _, err := b.Refund(money.NewMinor(1001)); err == nil {
+		t.Fatalf("expected error for refund exceeding gross")
+	}
+	if _, err := b.Refund(money.NewMinor(-1)); err == nil {
+		t.Fatalf("expected error for negative refund")
+	}
+}
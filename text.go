@@ -0,0 +1,17 @@
+package money
+
+// MarshalText implements encoding.TextMarshaler: "12.34".
+// Used for JSON map keys, XML attributes, YAML/TOML and env decoders.
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.StringFixed2()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with ParseString semantics.
+func (a *Amount) UnmarshalText(b []byte) error {
+	parsed, err := ParseString(string(b))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
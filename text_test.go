@@ -0,0 +1,90 @@
+package money_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+var (
+	_ encoding.TextMarshaler   = money.Amount(0)
+	_ encoding.TextUnmarshaler = (*money.Amount)(nil)
+)
+
+func TestAmount_TextRoundTrip(t *testing.T) {
+	for _, minor := range []int64{0, 1, -1, 1234, -99999, math.MaxInt64, math.MinInt64} {
+		a := money.NewMinor(minor)
+		b, err := a.MarshalText()
+		if err != nil {
+			t.Fatalf("marshal err: %v", err)
+		}
+		if string(b) != a.StringFixed2() {
+			t.Fatalf("got=%q want=%q", b, a.StringFixed2())
+		}
+
+		var back money.Amount
+		if err := back.UnmarshalText(b); err != nil {
+			t.Fatalf("unmarshal err: %v", err)
+		}
+		if back != a {
+			t.Fatalf("round-trip got=%d want=%d", back.Minor(), minor)
+		}
+
+		// MarshalJSON is the quoted text form
+		j, _ := json.Marshal(a)
+		if string(j) != `"`+string(b)+`"` {
+			t.Fatalf("json=%s text=%s", j, b)
+		}
+	}
+}
+
+func TestAmount_UnmarshalText_Invalid(t *testing.T) {
+	var a money.Amount
+	for _, in := range []string{"", "12.345", "abc"} {
+		if err := a.UnmarshalText([]byte(in)); err == nil {
+			t.Fatalf("in=%q expected error", in)
+		}
+	}
+}
+
+func TestAmount_JSONMapKey(t *testing.T) {
+	m := map[money.Amount]string{
+		money.NewMinor(1000): "cheap",
+		money.NewMinor(2550): "mid",
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	if string(b) != `{"10.00":"cheap","25.50":"mid"}` {
+		t.Fatalf("got=%s", b)
+	}
+
+	var back map[money.Amount]string
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if back[money.NewMinor(2550)] != "mid" || len(back) != 2 {
+		t.Fatalf("unexpected map: %v", back)
+	}
+
+	if err := json.Unmarshal([]byte(`{"1.234":"x"}`), &back); err == nil {
+		t.Fatalf("expected error for invalid key")
+	}
+}
+
+func TestAmount_JSONMapKey_Legacy(t *testing.T) {
+	// Keys written before TextMarshaler were minor units; they now read as whole lira.
+	legacy := []byte(`{"1234":1}`)
+	var m map[money.Amount]int
+	if err := json.Unmarshal(legacy, &m); err != nil || m[money.NewMinor(123400)] != 1 {
+		t.Fatalf("got=%v err=%v", m, err)
+	}
+	var old map[int64]int
+	if err := json.Unmarshal(legacy, &old); err != nil || old[1234] != 1 {
+		t.Fatalf("legacy got=%v err=%v", old, err)
+	}
+}
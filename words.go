@@ -0,0 +1,136 @@
+package money
+
+import "strings"
+
+// Language selects the language of Amount.Words.
+type Language int
+
+const (
+	Turkish Language = iota
+	English
+)
+
+// Words spells out the amount in lira and kuruş for invoices.
+// Turkish follows the e-Fatura form: 123450 -> "YALNIZ BİNİKİYÜZOTUZDÖRT TÜRK LİRASI ELLİ KURUŞ".
+// English: 123450 -> "one thousand two hundred thirty-four Turkish lira and fifty kuruş".
+// Zero kuruş is omitted; zero lira is omitted when there are kuruş; zero is "SIFIR TÜRK LİRASI".
+func (a Amount) Words(lang Language) string {
+	mag := uint64(a)
+	if a < 0 {
+		mag = -mag
+	}
+	lira, kurus := mag/100, mag%100
+
+	switch lang {
+	case Turkish:
+		parts := []string{"YALNIZ"}
+		if a < 0 {
+			parts = append(parts, "EKSİ")
+		}
+		if lira > 0 || kurus == 0 {
+			parts = append(parts, wordsTR(lira), "TÜRK LİRASI")
+		}
+		if kurus > 0 {
+			parts = append(parts, wordsTR(kurus), "KURUŞ")
+		}
+		return strings.Join(parts, " ")
+
+	case English:
+		var parts []string
+		if a < 0 {
+			parts = append(parts, "minus")
+		}
+		if lira > 0 || kurus == 0 {
+			parts = append(parts, wordsEN(lira), "Turkish lira")
+		}
+		if kurus > 0 {
+			if lira > 0 {
+				parts = append(parts, "and")
+			}
+			parts = append(parts, wordsEN(kurus), "kuruş")
+		}
+		return strings.Join(parts, " ")
+
+	default:
+		panic("money: unsupported language")
+	}
+}
+
+var (
+	trOnes   = [...]string{"", "BİR", "İKİ", "ÜÇ", "DÖRT", "BEŞ", "ALTI", "YEDİ", "SEKİZ", "DOKUZ"}
+	trTens   = [...]string{"", "ON", "YİRMİ", "OTUZ", "KIRK", "ELLİ", "ALTMIŞ", "YETMİŞ", "SEKSEN", "DOKSAN"}
+	trScales = [...]string{"", "BİN", "MİLYON", "MİLYAR", "TRİLYON", "KATRİLYON", "KENTİLYON"}
+)
+
+// wordsTR writes n in Turkish invoice form (concatenated, upper case).
+func wordsTR(n uint64) string {
+	if n == 0 {
+		return "SIFIR"
+	}
+	var groups []uint64
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, n%1000)
+	}
+
+	var b strings.Builder
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		// "BİN", not "BİRBİN"
+		if !(i == 1 && g == 1) {
+			if h := g / 100; h > 0 {
+				if h > 1 {
+					b.WriteString(trOnes[h])
+				}
+				b.WriteString("YÜZ")
+			}
+			b.WriteString(trTens[g/10%10])
+			b.WriteString(trOnes[g%10])
+		}
+		b.WriteString(trScales[i])
+	}
+	return b.String()
+}
+
+var (
+	enOnes = [...]string{"", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	enTens   = [...]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	enScales = [...]string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// wordsEN writes n in English (short scale, lower case).
+func wordsEN(n uint64) string {
+	if n == 0 {
+		return "zero"
+	}
+	var groups []uint64
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, n%1000)
+	}
+
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		if h := g / 100; h > 0 {
+			parts = append(parts, enOnes[h], "hundred")
+		}
+		switch r := g % 100; {
+		case r >= 20 && r%10 != 0:
+			parts = append(parts, enTens[r/10]+"-"+enOnes[r%10])
+		case r >= 20:
+			parts = append(parts, enTens[r/10])
+		case r > 0:
+			parts = append(parts, enOnes[r])
+		}
+		if i > 0 {
+			parts = append(parts, enScales[i])
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,64 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmount_Words_Turkish(t *testing.T) {
+	cases := []struct {
+		minor int64
+		want  string
+	}{
+		{123450, "YALNIZ BİNİKİYÜZOTUZDÖRT TÜRK LİRASI ELLİ KURUŞ"},
+		{0, "YALNIZ SIFIR TÜRK LİRASI"},
+		{1, "YALNIZ BİR KURUŞ"},
+		{100, "YALNIZ BİR TÜRK LİRASI"},
+		{10000, "YALNIZ YÜZ TÜRK LİRASI"},
+		{100000, "YALNIZ BİN TÜRK LİRASI"},
+		{200000, "YALNIZ İKİBİN TÜRK LİRASI"},
+		{10100000, "YALNIZ YÜZBİRBİN TÜRK LİRASI"},
+		{100000000, "YALNIZ BİRMİLYON TÜRK LİRASI"},
+		{100100099, "YALNIZ BİRMİLYONBİN TÜRK LİRASI DOKSANDOKUZ KURUŞ"},
+		{-550, "YALNIZ EKSİ BEŞ TÜRK LİRASI ELLİ KURUŞ"},
+		{math.MaxInt64, "YALNIZ DOKSANİKİKATRİLYONİKİYÜZOTUZÜÇTRİLYONYEDİYÜZYİRMİMİLYARÜÇYÜZALTMIŞSEKİZMİLYONBEŞYÜZKIRKYEDİBİNYEDİYÜZELLİSEKİZ TÜRK LİRASI YEDİ KURUŞ"},
+		{math.MinInt64, "YALNIZ EKSİ DOKSANİKİKATRİLYONİKİYÜZOTUZÜÇTRİLYONYEDİYÜZYİRMİMİLYARÜÇYÜZALTMIŞSEKİZMİLYONBEŞYÜZKIRKYEDİBİNYEDİYÜZELLİSEKİZ TÜRK LİRASI SEKİZ KURUŞ"},
+	}
+	for _, tc := range cases {
+		if got := money.NewMinor(tc.minor).Words(money.Turkish); got != tc.want {
+			t.Fatalf("minor=%d\ngot=%q\nwant=%q", tc.minor, got, tc.want)
+		}
+	}
+}
+
+func TestAmount_Words_English(t *testing.T) {
+	cases := []struct {
+		minor int64
+		want  string
+	}{
+		{123450, "one thousand two hundred thirty-four Turkish lira and fifty kuruş"},
+		{0, "zero Turkish lira"},
+		{1, "one kuruş"},
+		{1100, "eleven Turkish lira"},
+		{2000000, "twenty thousand Turkish lira"},
+		{100000000, "one million Turkish lira"},
+		{-4099, "minus forty Turkish lira and ninety-nine kuruş"},
+		{math.MaxInt64, "ninety-two quadrillion two hundred thirty-three trillion seven hundred twenty billion three hundred sixty-eight million five hundred forty-seven thousand seven hundred fifty-eight Turkish lira and seven kuruş"},
+	}
+	for _, tc := range cases {
+		if got := money.NewMinor(tc.minor).Words(money.English); got != tc.want {
+			t.Fatalf("minor=%d\ngot=%q\nwant=%q", tc.minor, got, tc.want)
+		}
+	}
+}
+
+func TestAmount_Words_UnsupportedLanguagePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected panic for unsupported language")
+		}
+	}()
+	_ = money.NewMinor(100).Words(money.Language(99))
+}
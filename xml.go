@@ -0,0 +1,67 @@
+package money
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// MarshalXML implements xml.Marshaler: <Price>12.34</Price>.
+func (a Amount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(a.StringFixed2(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler with ParseString semantics.
+// Child elements are rejected.
+func (a *Amount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text []byte
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			text = append(text, t...)
+		case xml.StartElement:
+			return fmt.Errorf("money: unexpected element <%s> inside <%s>", t.Name.Local, start.Name.Local)
+		case xml.EndElement:
+			return a.UnmarshalText(text)
+		}
+	}
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr: price="12.34".
+func (a Amount) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: a.StringFixed2()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr with ParseString semantics.
+func (a *Amount) UnmarshalXMLAttr(attr xml.Attr) error {
+	return a.UnmarshalText([]byte(attr.Value))
+}
+
+// UBLAmount is a UBL-TR (e-Fatura) amount with its currency:
+// <cbc:PayableAmount currencyID="TRY">1234.56</cbc:PayableAmount>
+type UBLAmount struct {
+	CurrencyID string `xml:"currencyID,attr"`
+	Amount     Amount `xml:",chardata"`
+}
+
+// UnmarshalXML decodes the amount strictly and requires currencyID.
+func (u *UBLAmount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var currency string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "currencyID" {
+			currency = attr.Value
+		}
+	}
+	if currency == "" {
+		return fmt.Errorf("money: <%s> missing currencyID", start.Name.Local)
+	}
+	var a Amount
+	if err := a.UnmarshalXML(d, start); err != nil {
+		return err
+	}
+	u.CurrencyID, u.Amount = currency, a
+	return nil
+}
@@ -0,0 +1,143 @@
+package money_test
+
+import (
+	"encoding/xml"
+	"os"
+	"testing"
+
+	"github.com/dahaiyiyimcom/money"
+)
+
+func TestAmount_XML(t *testing.T) {
+	type product struct {
+		XMLName xml.Name     `xml:"Product"`
+		Price   money.Amount `xml:"Price"`
+		List    money.Amount `xml:"list,attr"`
+	}
+
+	b, err := xml.Marshal(product{Price: money.NewMinor(1234), List: money.NewMinor(-50)})
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	want := `<Product list="-0.50"><Price>12.34</Price></Product>`
+	if string(b) != want {
+		t.Fatalf("got=%s want=%s", b, want)
+	}
+
+	var back product
+	if err := xml.Unmarshal(b, &back); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+	if back.Price.Minor() != 1234 || back.List.Minor() != -50 {
+		t.Fatalf("got=%+v", back)
+	}
+}
+
+func TestAmount_XML_Legacy(t *testing.T) {
+	// Documents written before XML support carried minor units; they now read as whole lira.
+	legacy := []byte(`<Product list="-50"><Price>1234</Price></Product>`)
+
+	var now struct {
+		Price money.Amount `xml:"Price"`
+		List  money.Amount `xml:"list,attr"`
+	}
+	if err := xml.Unmarshal(legacy, &now); err != nil || now.Price.Minor() != 123400 || now.List.Minor() != -5000 {
+		t.Fatalf("got=%+v err=%v", now, err)
+	}
+
+	var old struct {
+		Price int64 `xml:"Price"`
+		List  int64 `xml:"list,attr"`
+	}
+	if err := xml.Unmarshal(legacy, &old); err != nil || money.NewMinor(old.Price).Minor() != 1234 || old.List != -50 {
+		t.Fatalf("legacy got=%+v err=%v", old, err)
+	}
+}
+
+func TestAmount_XML_Strict(t *testing.T) {
+	type product struct {
+		Price money.Amount `xml:"Price"`
+		List  money.Amount `xml:"list,attr"`
+	}
+	cases := []string{
+		`<P><Price>12.345</Price></P>`,
+		`<P><Price>1,234.50</Price></P>`,
+		`<P><Price></Price></P>`,
+		`<P><Price>12<b/>34</Price></P>`,
+		`<P list="abc"><Price>1</Price></P>`,
+	}
+	for _, in := range cases {
+		var p product
+		if err := xml.Unmarshal([]byte(in), &p); err == nil {
+			t.Fatalf("in=%s expected error", in)
+		}
+	}
+}
+
+func TestUBLAmount_Marshal(t *testing.T) {
+	type total struct {
+		XMLName       xml.Name        `xml:"cac:LegalMonetaryTotal"`
+		PayableAmount money.UBLAmount `xml:"cbc:PayableAmount"`
+	}
+	b, err := xml.Marshal(total{PayableAmount: money.UBLAmount{CurrencyID: "TRY", Amount: money.NewMinor(123456)}})
+	if err != nil {
+		t.Fatalf("marshal err: %v", err)
+	}
+	want := `<cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="TRY">1234.56</cbc:PayableAmount></cac:LegalMonetaryTotal>`
+	if string(b) != want {
+		t.Fatalf("got=%s\nwant=%s", b, want)
+	}
+}
+
+type ublInvoice struct {
+	TaxTotal struct {
+		TaxAmount money.UBLAmount `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 TaxAmount"`
+	} `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2 TaxTotal"`
+	LegalMonetaryTotal struct {
+		LineExtensionAmount money.UBLAmount `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 LineExtensionAmount"`
+		TaxExclusiveAmount  money.UBLAmount `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 TaxExclusiveAmount"`
+		TaxInclusiveAmount  money.UBLAmount `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 TaxInclusiveAmount"`
+		PayableAmount       money.UBLAmount `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 PayableAmount"`
+	} `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2 LegalMonetaryTotal"`
+}
+
+func TestUBLAmount_SampleInvoice(t *testing.T) {
+	b, err := os.ReadFile("testdata/ubl/invoice.xml")
+	if err != nil {
+		t.Fatalf("read testdata: %v", err)
+	}
+	var inv ublInvoice
+	if err := xml.Unmarshal(b, &inv); err != nil {
+		t.Fatalf("unmarshal err: %v", err)
+	}
+
+	m := inv.LegalMonetaryTotal
+	if m.PayableAmount.CurrencyID != "TRY" || m.PayableAmount.Amount.Minor() != 123450 {
+		t.Fatalf("payable got=%+v", m.PayableAmount)
+	}
+	// TaxExclusive + Tax == TaxInclusive
+	if m.TaxExclusiveAmount.Amount.Add(inv.TaxTotal.TaxAmount.Amount) != m.TaxInclusiveAmount.Amount {
+		t.Fatalf("totals do not reconcile: %+v tax=%+v", m, inv.TaxTotal.TaxAmount)
+	}
+	if got := m.PayableAmount.Amount.Words(money.Turkish); got != "YALNIZ BİNİKİYÜZOTUZDÖRT TÜRK LİRASI ELLİ KURUŞ" {
+		t.Fatalf("words got=%q", got)
+	}
+}
+
+func TestUBLAmount_SampleInvoice_Invalid(t *testing.T) {
+	b, err := os.ReadFile("testdata/ubl/invoice_bad_amount.xml")
+	if err != nil {
+		t.Fatalf("read testdata: %v", err)
+	}
+	var inv ublInvoice
+	if err := xml.Unmarshal(b, &inv); err == nil {
+		t.Fatalf("expected error for 3-decimal amount")
+	}
+}
+
+func TestUBLAmount_MissingCurrency(t *testing.T) {
+	var u money.UBLAmount
+	if err := xml.Unmarshal([]byte(`<PayableAmount>12.34</PayableAmount>`), &u); err == nil {
+		t.Fatalf("expected error for missing currencyID")
+	}
+}